@@ -12,8 +12,10 @@ import (
 // NewAnteHandler returns an ante handler responsible for attempting to route an
 // Ethereum or SDK transaction to an internal ante handler for performing
 // transaction-level processing (e.g. fee payment, signature verification) before
-// being passed onto it's respective handler.
-func NewAnteHandler(options ante.HandlerOptions) sdk.AnteHandler {
+// being passed onto it's respective handler. Cosmos transactions may pay fees
+// in the fee denoms priced by feeDenomKeeper, which are converted into
+// baseDenom.
+func NewAnteHandler(options ante.HandlerOptions, feeDenomKeeper FeeDenomKeeper, baseDenom string) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
 	) (newCtx sdk.Context, err error) {
@@ -29,7 +31,7 @@ func NewAnteHandler(options ante.HandlerOptions) sdk.AnteHandler {
 					anteHandler = newMonoEVMAnteHandler(options)
 				case "/cosmos.evm.types.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
-					anteHandler = newCosmosAnteHandler(options)
+					anteHandler = newCosmosAnteHandler(options, feeDenomKeeper, baseDenom)
 				default:
 					return ctx, errorsmod.Wrapf(
 						errortypes.ErrUnknownExtensionOptions,
@@ -44,7 +46,7 @@ func NewAnteHandler(options ante.HandlerOptions) sdk.AnteHandler {
 		// handle as totally normal Cosmos SDK tx
 		switch tx.(type) {
 		case sdk.Tx:
-			anteHandler = newCosmosAnteHandler(options)
+			anteHandler = newCosmosAnteHandler(options, feeDenomKeeper, baseDenom)
 		default:
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid transaction type: %T", tx)
 		}
@@ -0,0 +1,309 @@
+package ante
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	cosmosante "github.com/cosmos/evm/ante/cosmos"
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	tokenfactorytypes "nimo-chain/x/tokenfactory/types"
+)
+
+// FeeDenomKeeper defines the keeper pricing the factory denoms that governance
+// accepts for transaction fees.
+type FeeDenomKeeper interface {
+	// FeeDenomRate returns the amount of the base fee denom that one unit of
+	// denom is worth, or ErrNotFeeDenom if the denom is not accepted.
+	FeeDenomRate(ctx context.Context, denom string) (sdkmath.LegacyDec, error)
+	// ConvertFee swaps a fee paid in a fee denom for its value in baseDenom,
+	// which is paid into the fee collector.
+	ConvertFee(ctx context.Context, payer sdk.AccAddress, fee sdk.Coin, baseDenom string) (sdk.Coin, error)
+}
+
+// NewTxFeeChecker returns the default SDK fee checker extended to fee denoms.
+// Fees paid in a fee denom are valued in baseDenom at its rate for the
+// validator min gas prices and the tx priority. The returned fee is the fee as
+// paid, which DeductFeeDecorator converts.
+func NewTxFeeChecker(k FeeDenomKeeper, baseDenom string) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		value, err := convertFee(ctx, k, baseDenom, feeCoins)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// Ensure that the provided fees meet a minimum threshold for the validator,
+		// if this is a CheckTx. This is only for local mempool purposes, and thus
+		// is only ran on check tx.
+		if ctx.IsCheckTx() {
+			minGasPrices := ctx.MinGasPrices()
+			if !minGasPrices.IsZero() {
+				requiredFees := make(sdk.Coins, len(minGasPrices))
+
+				// Determine the required fees by multiplying each required minimum gas
+				// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+				glDec := sdkmath.LegacyNewDec(int64(gas))
+				for i, gp := range minGasPrices {
+					fee := gp.Amount.Mul(glDec)
+					requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+				}
+
+				if !value.IsAnyGTE(requiredFees) {
+					return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "insufficient fees; got: %s worth %s required: %s", feeCoins, value, requiredFees)
+				}
+			}
+		}
+
+		priority := getTxPriority(value, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
+
+// MinGasPriceDecorator extends the cosmos/evm MinGasPriceDecorator to fees paid
+// in a single fee denom, which are valued in the EVM denom at its rate. Any
+// other fee is checked by the embedded decorator.
+type MinGasPriceDecorator struct {
+	cosmosante.MinGasPriceDecorator
+	feemarketKeeper anteinterfaces.FeeMarketKeeper
+	feeDenomKeeper  FeeDenomKeeper
+}
+
+// NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only
+// for Cosmos transactions.
+func NewMinGasPriceDecorator(fk anteinterfaces.FeeMarketKeeper, ek anteinterfaces.EVMKeeper, k FeeDenomKeeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{
+		MinGasPriceDecorator: cosmosante.NewMinGasPriceDecorator(fk, ek),
+		feemarketKeeper:      fk,
+		feeDenomKeeper:       k,
+	}
+}
+
+func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.GetFee()) != 1 {
+		return mpd.MinGasPriceDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	fee := feeTx.GetFee()[0]
+	rate, err := mpd.feeDenomKeeper.FeeDenomRate(ctx, fee.Denom)
+	if errors.Is(err, tokenfactorytypes.ErrNotFeeDenom) {
+		return mpd.MinGasPriceDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// Short-circuit if simulating or if min gas price is 0
+	minGasPrice := mpd.feemarketKeeper.GetParams(ctx).MinGasPrice
+	if simulate || minGasPrice.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+	if err != nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInsufficientFee, err.Error())
+	}
+
+	required := minGasPrice.MulInt64(int64(feeTx.GetGas())).Ceil().RoundInt()
+	if value := rate.MulInt(fee.Amount).TruncateInt(); value.LT(required) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
+			"provided fee < minimum global fee (%s worth %s < %s). Please increase the gas price.",
+			fee, value, required)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// DeductFeeDecorator extends the SDK DeductFeeDecorator to fees paid in fee
+// denoms, which are swapped for their value in the base denom before reaching
+// the fee collector, so that validators are only ever paid in the base denom.
+// Any other fee is deducted by the embedded decorator.
+type DeductFeeDecorator struct {
+	authante.DeductFeeDecorator
+	accountKeeper  authante.AccountKeeper
+	bankKeeper     authtypes.BankKeeper
+	feegrantKeeper authante.FeegrantKeeper
+	txFeeChecker   authante.TxFeeChecker
+	feeDenomKeeper FeeDenomKeeper
+	baseDenom      string
+}
+
+// NewDeductFeeDecorator creates a new DeductFeeDecorator instance. The fee
+// checker defaults to NewTxFeeChecker.
+func NewDeductFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, fk authante.FeegrantKeeper, tfc authante.TxFeeChecker, k FeeDenomKeeper, baseDenom string) DeductFeeDecorator {
+	if tfc == nil {
+		tfc = NewTxFeeChecker(k, baseDenom)
+	}
+
+	return DeductFeeDecorator{
+		DeductFeeDecorator: authante.NewDeductFeeDecorator(ak, bk, fk, tfc),
+		accountKeeper:      ak,
+		bankKeeper:         bk,
+		feegrantKeeper:     fk,
+		txFeeChecker:       tfc,
+		feeDenomKeeper:     k,
+		baseDenom:          baseDenom,
+	}
+}
+
+func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || !hasFeeDenom(ctx, dfd.feeDenomKeeper, feeTx.GetFee()) {
+		return dfd.DeductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	var (
+		priority int64
+		err      error
+	)
+
+	fee := feeTx.GetFee()
+	if !simulate {
+		fee, priority, err = dfd.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+	if err := dfd.deductFee(ctx, tx, fee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// deductFee deducts fee from the fee payer, or the fee granter if one is set,
+// converting the coins of fee denoms.
+func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := dfd.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return fmt.Errorf("fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+	deductFeesFrom := sdk.AccAddress(feePayer)
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if feeGranter != nil {
+		feeGranterAddr := sdk.AccAddress(feeGranter)
+
+		if dfd.feegrantKeeper == nil {
+			return errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !bytes.Equal(feeGranterAddr, feePayer) {
+			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
+			if err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranterAddr
+	}
+
+	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return errortypes.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	// convert the fee denoms and deduct the rest of the fees
+	if !fee.IsValid() {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFee, "invalid fee amount: %s", fee)
+	}
+	baseFee := sdk.NewCoins()
+	for _, coin := range fee {
+		_, err := dfd.feeDenomKeeper.ConvertFee(ctx, deductFeesFrom, coin, dfd.baseDenom)
+		switch {
+		case errors.Is(err, tokenfactorytypes.ErrNotFeeDenom):
+			baseFee = baseFee.Add(coin)
+		case err != nil:
+			return err
+		}
+	}
+	if !baseFee.IsZero() {
+		if err := authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, baseFee); err != nil {
+			return err
+		}
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+		),
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// hasFeeDenom reports whether fee has a coin of a fee denom.
+func hasFeeDenom(ctx sdk.Context, k FeeDenomKeeper, fee sdk.Coins) bool {
+	for _, coin := range fee {
+		if _, err := k.FeeDenomRate(ctx, coin.Denom); !errors.Is(err, tokenfactorytypes.ErrNotFeeDenom) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// convertFee returns fee with the coins of fee denoms converted into
+// baseDenom, rounded down.
+func convertFee(ctx sdk.Context, k FeeDenomKeeper, baseDenom string, fee sdk.Coins) (sdk.Coins, error) {
+	value := sdk.NewCoins()
+	for _, coin := range fee {
+		rate, err := k.FeeDenomRate(ctx, coin.Denom)
+		switch {
+		case errors.Is(err, tokenfactorytypes.ErrNotFeeDenom):
+			value = value.Add(coin)
+		case err != nil:
+			return nil, errorsmod.Wrap(errortypes.ErrInsufficientFee, err.Error())
+		default:
+			value = value.Add(sdk.NewCoin(baseDenom, rate.MulInt(coin.Amount).TruncateInt()))
+		}
+	}
+
+	return value, nil
+}
+
+// getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
+// provided in a transaction.
+// NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
+// where txs with multiple coins could not be prioritize as expected.
+func getTxPriority(fee sdk.Coins, gas int64) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	appante "nimo-chain/app/ante"
+	tokenfactorykeeper "nimo-chain/x/tokenfactory/keeper"
+	tokenfactorytypes "nimo-chain/x/tokenfactory/types"
+)
+
+func TestDeductFeeDecorator(t *testing.T) {
+	payerAddr := sdk.AccAddress("payerAddr___________")
+	app, ctx := setupApp(t, payerAddr)
+	srv := tokenfactorykeeper.NewMsgServerImpl(app.TokenfactoryKeeper)
+
+	ownerAddr := sdk.AccAddress("signerAddr__________")
+	owner := ownerAddr.String()
+	payer := payerAddr.String()
+	authority, err := app.AuthKeeper.AddressCodec().BytesToString(app.TokenfactoryKeeper.GetAuthority())
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(ctx, &tokenfactorytypes.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(ctx, &tokenfactorytypes.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(100), Recipient: payer})
+	require.NoError(t, err)
+	_, err = srv.SetFeeDenom(ctx, &tokenfactorytypes.MsgSetFeeDenom{Authority: authority, FeeDenom: tokenfactorytypes.FeeDenom{Denom: token, FixedRate: math.LegacyNewDec(2)}})
+	require.NoError(t, err)
+
+	// The owner backs the conversion with the bond denom
+	bond := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, bond))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, ownerAddr, bond))
+
+	builder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(payerAddr, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 1)))))
+	builder.SetGasLimit(100_000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(token, 10)))
+
+	feeCollector := app.AuthKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := app.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+	dfd := appante.NewDeductFeeDecorator(app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, nil, app.TokenfactoryKeeper, sdk.DefaultBondDenom)
+	_, err = dfd.AnteHandle(ctx, builder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+
+	// The fee collector receives the value of the fee in the bond denom
+	require.Equal(t, collected.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, math.NewInt(90), app.BankKeeper.GetBalance(ctx, payerAddr, token).Amount)
+	require.Equal(t, math.NewInt(10), app.BankKeeper.GetBalance(ctx, ownerAddr, token).Amount)
+	require.Equal(t, math.NewInt(980), app.BankKeeper.GetBalance(ctx, ownerAddr, sdk.DefaultBondDenom).Amount)
+}
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -59,6 +61,7 @@ import (
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 	gnovmmodulekeeper "github.com/ignite/gnovm/x/gnovm/keeper"
 
+	appante "nimo-chain/app/ante"
 	"nimo-chain/docs"
 	nimochainmodulekeeper "nimo-chain/x/nimochain/keeper"
 	tokenfactorymodulekeeper "nimo-chain/x/tokenfactory/keeper"
@@ -175,6 +178,21 @@ func New(
 			depinject.Supply(
 				appOpts, // supply app options
 				logger,  // supply logger
+				// the ERC-20 keeper is built after injection, so tokenfactory
+				// gets a pointer that is filled in by registerEVMModules
+				&app.Erc20Keeper,
+				// and reads the EVM accounts at the ERC-20 addresses it
+				// registers through the EVM keeper
+				evmAccountKeeper{app},
+				// likewise the wasm keeper is filled in by registerWasmModules
+				// and called by the tokenfactory before-send hooks
+				&app.WasmKeeper,
+				// and the IBC channel keeper, from which the escrow accounts
+				// of channels opened before tracking are seeded
+				ibcChannelKeeper{app},
+				// and the bank metadata store, from which deleted denoms
+				// drop their metadata
+				bankDenomMetadataKeeper{app},
 				// here alternative options can be supplied to the DI container.
 				// those options can be used f.e to override the default behavior of some modules.
 				// for instance supplying a custom address codec for not using bech32 addresses.
@@ -232,6 +250,7 @@ func New(
 		BankKeeper:      app.BankKeeper,
 		SignModeHandler: app.txConfig.SignModeHandler(),
 		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		TxFeeChecker:    appante.NewTxFeeChecker(app.TokenfactoryKeeper, sdk.DefaultBondDenom),
 	}); err != nil {
 		panic(err)
 	}
@@ -359,4 +378,20 @@ func (app *App) GetStoreKeysMap() map[string]*storetypes.KVStoreKey {
 	}
 
 	return storeKeysMap
-}
\ No newline at end of file
+}
+
+// bankDenomMetadataKeeper removes bank metadata through the x/bank store, as
+// the bank keeper has no method for it. It lets the tokenfactory drop the
+// metadata of deleted denoms.
+type bankDenomMetadataKeeper struct {
+	app *App
+}
+
+func (k bankDenomMetadataKeeper) RemoveDenomMetaData(ctx context.Context, denom string) error {
+	bankKeeper, ok := k.app.BankKeeper.(bankkeeper.BaseKeeper)
+	if !ok {
+		return fmt.Errorf("unexpected bank keeper %T", k.app.BankKeeper)
+	}
+
+	return bankKeeper.BaseViewKeeper.DenomMetadata.Remove(ctx, denom)
+}
@@ -1,12 +1,32 @@
 package app
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	tokenfactoryprecompile "nimo-chain/x/tokenfactory/precompile"
+)
 
 func init() {
 	// Set bond denom
 
 	sdk.DefaultBondDenom = "unimo"
 
+	// Activate the precompiles registered in postRegisterEVMModules in new
+	// genesis files, next to the default ones
+	for _, precompile := range []string{
+		evmtypes.P256PrecompileAddress,
+		evmtypes.Bech32PrecompileAddress,
+		tokenfactoryprecompile.PrecompileAddress,
+	} {
+		if !slices.Contains(evmtypes.DefaultStaticPrecompiles, precompile) {
+			evmtypes.DefaultStaticPrecompiles = append(evmtypes.DefaultStaticPrecompiles, precompile)
+		}
+	}
+	slices.Sort(evmtypes.DefaultStaticPrecompiles)
+
 	// Set address prefixes
 	accountPubKeyPrefix := AccountAddressPrefix + "pub"
 	validatorAddressPrefix := AccountAddressPrefix + "valoper"
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"hash/fnv"
 	"maps"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -17,10 +19,12 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cast"
@@ -38,9 +42,13 @@ import (
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/ethereum/go-ethereum/common"
 	gethvm "github.com/ethereum/go-ethereum/core/vm"
+
+	tokenfactorymodulekeeper "nimo-chain/x/tokenfactory/keeper"
+	tokenfactoryprecompile "nimo-chain/x/tokenfactory/precompile"
 )
 
 // registerEVMModules register EVM keepers and non dependency inject modules.
@@ -141,12 +149,41 @@ func (app *App) postRegisterEVMModules() error {
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
 
+	tokenfactoryPrecompile, err := tokenfactoryprecompile.NewPrecompile(
+		tokenfactorymodulekeeper.NewMsgServerImpl(app.TokenfactoryKeeper),
+		tokenfactorymodulekeeper.NewQueryServerImpl(app.TokenfactoryKeeper),
+		app.BankKeeper,
+		app.AuthKeeper.AddressCodec(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to instantiate tokenfactory precompile: %w", err)
+	}
+	precompiles[tokenfactoryPrecompile.Address()] = tokenfactoryPrecompile
+	app.BankKeeper.AppendSendRestriction(blockPrecompileRecipients(tokenfactoryPrecompile.Address()))
+
 	// add more stateful precompiles here, if needed.
 
 	_ = app.EVMKeeper.WithStaticPrecompiles(precompiles)
 	return nil
 }
 
+// blockPrecompileRecipients returns a send restriction that rejects transfers
+// to the given precompiles. Coins sent there could never be moved again, and
+// the bank module only blocks module accounts.
+func blockPrecompileRecipients(precompiles ...common.Address) banktypes.SendRestrictionFn {
+	blocked := make(map[string]bool, len(precompiles))
+	for _, precompile := range precompiles {
+		blocked[string(precompile.Bytes())] = true
+	}
+
+	return func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if blocked[string(toAddr)] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+		}
+		return toAddr, nil
+	}
+}
+
 // setEVMMempool sets the EVM priority nonce mempool
 // it is required for the ethereum json rpc server to work
 func (app *App) setEVMMempool() {
@@ -274,3 +311,13 @@ func RegisterEVM(cdc codec.Codec, interfaceRegistry codectypes.InterfaceRegistry
 func ProvideMsgEthereumTxCustomGetSigner() signing.CustomGetSigner {
 	return evmtypes.MsgEthereumTxCustomGetSigner
 }
+
+// evmAccountKeeper reads EVM accounts for the tokenfactory through the EVM
+// keeper, which is only built after dependency injection.
+type evmAccountKeeper struct {
+	app *App
+}
+
+func (k evmAccountKeeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account {
+	return k.app.EVMKeeper.GetAccount(ctx, addr)
+}
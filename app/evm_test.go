@@ -0,0 +1,137 @@
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tokenfactorykeeper "nimo-chain/x/tokenfactory/keeper"
+	tokenfactoryprecompile "nimo-chain/x/tokenfactory/precompile"
+	tokenfactorytypes "nimo-chain/x/tokenfactory/types"
+)
+
+// setupApp returns an app past its first block, with caller funded in
+// genesis, and a context on top of its state.
+func setupApp(t *testing.T, caller sdk.AccAddress) (*App, sdk.Context) {
+	t.Helper()
+
+	// The wasm VM locks its directory under DefaultNodeHome
+	home := t.TempDir()
+	defaultNodeHome := DefaultNodeHome
+	DefaultNodeHome = home
+	t.Cleanup(func() { DefaultNodeHome = defaultNodeHome })
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = home
+	appOptions[flags.FlagChainID] = SimAppChainID
+
+	app := New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(SimAppChainID))
+
+	valSet, err := simtestutil.CreateRandomValidatorSet()
+	require.NoError(t, err)
+	callerAcc := authtypes.NewBaseAccountWithAddress(caller)
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{callerAcc})
+	require.NoError(t, err)
+	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	_, err = app.InitChain(&abci.RequestInitChain{
+		ChainId:         SimAppChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, NextValidatorsHash: valSet.Hash()})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	return app, app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight(), ChainID: SimAppChainID, ProposerAddress: valSet.Proposer.Address})
+}
+
+func TestDefaultGenesisPrecompiles(t *testing.T) {
+	app, _ := setupApp(t, sdk.AccAddress("signerAddr__________"))
+
+	var genesis evmtypes.GenesisState
+	require.NoError(t, app.AppCodec().UnmarshalJSON(app.DefaultGenesis()[evmtypes.ModuleName], &genesis))
+	require.NoError(t, genesis.Validate())
+
+	// The tokenfactory precompile is added to the ones the app registers
+	require.Equal(t, []string{
+		evmtypes.P256PrecompileAddress,
+		evmtypes.Bech32PrecompileAddress,
+		tokenfactoryprecompile.PrecompileAddress,
+	}, genesis.Params.ActiveStaticPrecompiles)
+}
+
+func TestTokenfactoryPrecompile(t *testing.T) {
+	caller := common.BytesToAddress([]byte("signerAddr__________"))
+	app, ctx := setupApp(t, caller.Bytes())
+
+	// The precompile is active from genesis
+	precompileAddr := common.HexToAddress(tokenfactoryprecompile.PrecompileAddress)
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, precompileAddr.Hex())
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, evmtypes.Bech32PrecompileAddress)
+	require.Contains(t, app.EVMKeeper.GetParams(ctx).ActiveStaticPrecompiles, evmtypes.P256PrecompileAddress)
+
+	// An EVM call reaches Run, which creates the denom for the caller
+	abi, err := tokenfactoryprecompile.LoadABI()
+	require.NoError(t, err)
+	input, err := abi.Pack(tokenfactoryprecompile.CreateDenomMethod, "token", "description", "TKN", int64(6), "", big.NewInt(100), true)
+	require.NoError(t, err)
+	res, err := app.EVMKeeper.CallEVMWithData(ctx, caller, &precompileAddr, input, true, nil)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+
+	owner, err := app.AuthKeeper.AddressCodec().BytesToString(caller.Bytes())
+	require.NoError(t, err)
+	denom, err := app.TokenfactoryKeeper.Denom.Get(ctx, "factory/"+owner+"/token")
+	require.NoError(t, err)
+	require.Equal(t, owner, denom.Owner)
+	require.Equal(t, math.NewInt(100), denom.MaxSupply)
+
+	// Coins cannot be sent to the precompile address
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, tokenfactorytypes.ModuleName, coins))
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokenfactorytypes.ModuleName, precompileAddr.Bytes(), coins)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestDeleteDenomRemovesERC20(t *testing.T) {
+	ownerAddr := sdk.AccAddress("signerAddr__________")
+	app, ctx := setupApp(t, ownerAddr)
+	srv := tokenfactorykeeper.NewMsgServerImpl(app.TokenfactoryKeeper)
+	owner := ownerAddr.String()
+
+	resp, err := srv.CreateDenom(ctx, &tokenfactorytypes.MsgCreateDenom{Owner: owner, Subdenom: "token", Ticker: "TKN", Precision: 6, MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	address := tokenfactorytypes.ERC20Address(resp.NewTokenDenom)
+	require.Equal(t, address.Hex(), resp.Erc20Address)
+	require.True(t, app.EVMKeeper.GetAccount(ctx, address).IsContract())
+
+	_, err = srv.DeleteDenom(ctx, &tokenfactorytypes.MsgDeleteDenom{Creator: owner, Denom: resp.NewTokenDenom})
+	require.NoError(t, err)
+	require.False(t, app.Erc20Keeper.IsDenomRegistered(ctx, resp.NewTokenDenom))
+	require.False(t, app.Erc20Keeper.IsDynamicPrecompileAvailable(ctx, address))
+	require.False(t, app.EVMKeeper.GetAccount(ctx, address).IsContract())
+
+	// A recreated denom gets its pair back
+	resp, err = srv.CreateDenom(ctx, &tokenfactorytypes.MsgCreateDenom{Owner: owner, Subdenom: "token", Ticker: "TKN", Precision: 6, MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	require.Equal(t, address.Hex(), resp.Erc20Address)
+}
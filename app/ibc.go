@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -29,12 +30,16 @@ import (
 	ibc "github.com/cosmos/ibc-go/v10/modules/core"
 	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" // nolint:staticcheck // Deprecated: params key table is needed for params migration
 	ibcconnectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	tokenfactorybindings "nimo-chain/x/tokenfactory/bindings"
+	tokenfactorymodule "nimo-chain/x/tokenfactory/module"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -117,6 +122,10 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
 
+	// record the escrow accounts that the tokenfactory exempts from transfer fees
+	transferStack = tokenfactorymodule.NewIBCMiddleware(app.TokenfactoryKeeper, transferStack)
+	transferStackV2 = tokenfactorymodule.NewIBCMiddlewareV2(app.TokenfactoryKeeper, transferStackV2)
+
 	// create IBC v1 router, add transfer route, then set it on the keeper
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
@@ -127,7 +136,7 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	ibcv2Router := ibcapi.NewRouter().
 		AddRoute(ibctransfertypes.PortID, transferStackV2)
 
-	wasmStack, err := app.registerWasmModules(appOpts)
+	wasmStack, err := app.registerWasmModules(appOpts, tokenfactorybindings.RegisterCustomPlugins(app.TokenfactoryKeeper)...)
 	if err != nil {
 		return err
 	}
@@ -182,3 +191,14 @@ func RegisterIBC(cdc codec.Codec) map[string]appmodule.AppModule {
 
 	return modules
 }
+
+// ibcChannelKeeper forwards to the IBC channel keeper, which is built by
+// registerIBCModules after dependency injection. It lets the tokenfactory
+// record the escrow accounts of the channels opened before it tracked them.
+type ibcChannelKeeper struct {
+	app *App
+}
+
+func (k ibcChannelKeeper) GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel {
+	return k.app.IBCKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, portPrefix)
+}
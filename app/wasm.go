@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
@@ -21,6 +22,7 @@ import (
 	baseevmante "github.com/cosmos/evm/ante"
 	
 	appante "nimo-chain/app/ante"
+	tokenfactorybindings "nimo-chain/x/tokenfactory/bindings"
 )
 
 // registerWasmModules register CosmWasm keepers and non dependency inject modules.
@@ -57,7 +59,7 @@ func (app *App) registerWasmModules(
 		DefaultNodeHome,
 		wasmConfig,
 		wasmtypes.VMConfig{},
-		wasmkeeper.BuiltInCapabilities(),
+		append(wasmkeeper.BuiltInCapabilities(), tokenfactorybindings.Capability),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
@@ -132,11 +134,13 @@ func (app *App) setWasmAnteHandler(txConfig client.TxConfig, wasmConfig wasmtype
 			SignModeHandler:        txConfig.SignModeHandler(),
 			FeegrantKeeper:         app.FeeGrantKeeper,
 			SigGasConsumer:         ante.DefaultSigVerificationGasConsumer,
-			TxFeeChecker:           nil, // Use default fee checker
+			TxFeeChecker:           appante.NewTxFeeChecker(app.TokenfactoryKeeper, sdk.DefaultBondDenom),
 			MaxTxGasWanted:         0, // 0 means no limit
 			ExtensionOptionChecker: nil,
 			PendingTxListener:      nil,
 		},
+		app.TokenfactoryKeeper,
+		sdk.DefaultBondDenom,
 	)
 
 	// Set the AnteHandler for the app
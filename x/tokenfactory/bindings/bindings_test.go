@@ -0,0 +1,319 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/CosmWasm/wasmd/x/wasm"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/bindings"
+	"nimo-chain/x/tokenfactory/keeper"
+	module "nimo-chain/x/tokenfactory/module"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+type testEnv struct {
+	ctx            sdk.Context
+	bankKeeper     bankkeeper.Keeper
+	tfKeeper       keeper.Keeper
+	wasmKeeper     wasmkeeper.Keeper
+	contractKeeper wasmtypes.ContractOpsKeeper
+}
+
+// setupEnv wires real auth, bank, tokenfactory and wasm keepers with the
+// tokenfactory plugins on a fresh store.
+func setupEnv(t *testing.T) testEnv {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, wasm.AppModuleBasic{}, module.AppModule{})
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, wasmtypes.StoreKey, types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB(), log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, cmtproto.Header{Height: 1, Time: time.Now()}, false, log.NewNopLogger())
+
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	addressCodec := addresscodec.NewBech32Codec(prefix)
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			types.ModuleName:     {authtypes.Minter, authtypes.Burner},
+			wasmtypes.ModuleName: {authtypes.Burner},
+		},
+		addressCodec,
+		prefix,
+		authority.String(),
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		nil,
+		authority.String(),
+		log.NewNopLogger(),
+	)
+
+	// The tokenfactory keeper calls before-send hooks through the wasm keeper,
+	// which is built afterwards with the tokenfactory plugins
+	var wasmKeeper wasmkeeper.Keeper
+	tfKeeper := keeper.NewKeeper(
+		runtime.NewKVStoreService(keys[types.StoreKey]),
+		runtime.EventService{},
+		encCfg.Codec,
+		addressCodec,
+		authority,
+		accountKeeper,
+		bankKeeper,
+		nil,
+		nil,
+		nil,
+		&wasmKeeper,
+		nil,
+		nil,
+	)
+	require.NoError(t, tfKeeper.Params.Set(ctx, types.DefaultParams()))
+
+	wasmKeeper = wasmkeeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
+		accountKeeper,
+		bankKeeper,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		baseapp.NewMsgServiceRouter(),
+		nil,
+		t.TempDir(),
+		wasmtypes.DefaultNodeConfig(),
+		wasmtypes.VMConfig{},
+		append(wasmkeeper.BuiltInCapabilities(), bindings.Capability),
+		authority.String(),
+		bindings.RegisterCustomPlugins(tfKeeper)...,
+	)
+	require.NoError(t, wasmKeeper.SetParams(ctx, wasmtypes.DefaultParams()))
+
+	return testEnv{
+		ctx:            ctx,
+		bankKeeper:     bankKeeper,
+		tfKeeper:       tfKeeper,
+		wasmKeeper:     wasmKeeper,
+		contractKeeper: wasmkeeper.NewDefaultPermissionKeeper(&wasmKeeper),
+	}
+}
+
+// instantiateCaller stores and instantiates testdata/tokenfactory_caller.wasm,
+// which forwards its execute messages and queries to the bindings.
+func instantiateCaller(t *testing.T, env testEnv, creator sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+
+	code, err := os.ReadFile("testdata/tokenfactory_caller.wasm")
+	require.NoError(t, err)
+
+	codeID, _, err := env.contractKeeper.Create(env.ctx, creator, code, nil)
+	require.NoError(t, err)
+
+	contract, _, err := env.contractKeeper.Instantiate(env.ctx, codeID, creator, nil, []byte("{}"), "tokenfactory caller", nil)
+	require.NoError(t, err)
+
+	return contract
+}
+
+func execute(env testEnv, contract, sender sdk.AccAddress, msg bindings.TokenFactoryMsg) error {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = env.contractKeeper.Execute(env.ctx, contract, sender, bz, nil)
+	return err
+}
+
+func query(t *testing.T, env testEnv, contract sdk.AccAddress, req bindings.TokenFactoryQuery, res any) {
+	t.Helper()
+
+	bz, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	out, err := env.wasmKeeper.QuerySmart(env.ctx, contract, bz)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(out, res))
+}
+
+func TestBindings(t *testing.T) {
+	env := setupEnv(t)
+
+	creator := sdk.AccAddress("creatorAddr_________")
+	holder := sdk.AccAddress("holderAddr__________")
+	contract := instantiateCaller(t, env, creator)
+	denom := "factory/" + contract.String() + "/lp"
+
+	// The contract creates and owns the denom
+	err := execute(env, contract, creator, bindings.TokenFactoryMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom:           "lp",
+		Ticker:             "LPT",
+		Precision:          6,
+		MaxSupply:          math.NewInt(1_000),
+		CanChangeMaxSupply: true,
+	}})
+	require.NoError(t, err)
+
+	var info bindings.DenomInfoResponse
+	query(t, env, contract, bindings.TokenFactoryQuery{DenomInfo: &bindings.DenomInfo{Denom: denom}}, &info)
+	require.Equal(t, denom, info.Denom)
+	require.Equal(t, contract.String(), info.Admin)
+	require.Equal(t, "LPT", info.Ticker)
+	require.Equal(t, int64(6), info.Precision)
+	require.Equal(t, math.NewInt(1_000), info.MaxSupply)
+
+	// Mint to a holder and to the contract, then burn from the contract
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(100), MintToAddress: holder.String()}})
+	require.NoError(t, err)
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(50), MintToAddress: contract.String()}})
+	require.NoError(t, err)
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{BurnTokens: &bindings.BurnTokens{Denom: denom, Amount: math.NewInt(20)}})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(100), env.bankKeeper.GetBalance(env.ctx, holder, denom).Amount)
+	require.Equal(t, math.NewInt(30), env.bankKeeper.GetBalance(env.ctx, contract, denom).Amount)
+
+	var supply bindings.SupplyResponse
+	query(t, env, contract, bindings.TokenFactoryQuery{Supply: &bindings.Supply{Denom: denom}}, &supply)
+	require.Equal(t, math.NewInt(130), supply.Supply)
+	require.Equal(t, math.NewInt(1_000), supply.MaxSupply)
+
+	// Minting past the max supply fails
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(1_000), MintToAddress: holder.String()}})
+	require.Error(t, err)
+
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{SetMetadata: &bindings.SetMetadata{
+		Denom:              denom,
+		Description:        "liquidity pool share",
+		MaxSupply:          math.NewInt(2_000),
+		CanChangeMaxSupply: true,
+	}})
+	require.NoError(t, err)
+	query(t, env, contract, bindings.TokenFactoryQuery{DenomInfo: &bindings.DenomInfo{Denom: denom}}, &info)
+	require.Equal(t, "liquidity pool share", info.Description)
+	require.Equal(t, math.NewInt(2_000), info.MaxSupply)
+
+	// The new admin is only proposed until it accepts
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{ChangeAdmin: &bindings.ChangeAdmin{Denom: denom, NewAdminAddress: holder.String()}})
+	require.NoError(t, err)
+
+	var admin bindings.AdminResponse
+	query(t, env, contract, bindings.TokenFactoryQuery{Admin: &bindings.Admin{Denom: denom}}, &admin)
+	require.Equal(t, contract.String(), admin.Admin)
+
+	_, err = keeper.NewMsgServerImpl(env.tfKeeper).AcceptOwnership(env.ctx, &types.MsgAcceptOwnership{NewOwner: holder.String(), Denom: denom})
+	require.NoError(t, err)
+	query(t, env, contract, bindings.TokenFactoryQuery{Admin: &bindings.Admin{Denom: denom}}, &admin)
+	require.Equal(t, holder.String(), admin.Admin)
+
+	// After handing the denom over the contract cannot mint anymore
+
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: math.NewInt(1), MintToAddress: holder.String()}})
+	require.Error(t, err)
+
+	// An empty message is not a known variant
+	err = execute(env, contract, creator, bindings.TokenFactoryMsg{})
+	require.Error(t, err)
+}
+
+func TestDispatchMsgResponses(t *testing.T) {
+	env := setupEnv(t)
+	messenger := bindings.CustomMessageDecorator(env.tfKeeper)(nil)
+
+	contract := sdk.AccAddress("contractAddr________")
+	bz, err := json.Marshal(bindings.TokenFactoryMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "lp", Ticker: "LPT", Precision: 6, MaxSupply: math.NewInt(1_000)}})
+	require.NoError(t, err)
+
+	// The handler response is reported back, not the request
+	_, data, msgResponses, err := messenger.DispatchMsg(env.ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	require.Len(t, msgResponses, 1)
+	require.Len(t, msgResponses[0], 1)
+
+	var res types.MsgCreateDenomResponse
+	require.Equal(t, sdk.MsgTypeURL(&res), msgResponses[0][0].TypeUrl)
+	require.NoError(t, res.Unmarshal(msgResponses[0][0].Value))
+	require.Equal(t, "factory/"+contract.String()+"/lp", res.NewTokenDenom)
+}
+
+// recordingMessenger records the messages handed on by the bindings.
+type recordingMessenger struct {
+	msgs []wasmvmtypes.CosmosMsg
+}
+
+func (r *recordingMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	r.msgs = append(r.msgs, msg)
+	return nil, nil, nil, nil
+}
+
+func TestBankBurn(t *testing.T) {
+	env := setupEnv(t)
+	wrapped := &recordingMessenger{}
+	messenger := bindings.CustomMessageDecorator(env.tfKeeper)(wrapped)
+	srv := keeper.NewMsgServerImpl(env.tfKeeper)
+
+	contract := sdk.AccAddress("contractAddr________")
+	resp, err := srv.CreateDenom(env.ctx, &types.MsgCreateDenom{Owner: contract.String(), Subdenom: "lp", Ticker: "LPT", Precision: 6, MaxSupply: math.NewInt(1_000)})
+	require.NoError(t, err)
+	denom := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(env.ctx, &types.MsgMintAndSendTokens{Creator: contract.String(), Denom: denom, Amount: math.NewInt(100), Recipient: contract.String()})
+	require.NoError(t, err)
+
+	// Factory denoms are burned through MsgBurn, other coins are handed on
+	burn := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{
+		wasmvmtypes.NewCoin(30, denom),
+		wasmvmtypes.NewCoin(5, "stake"),
+	}}}}
+	_, _, _, err = messenger.DispatchMsg(env.ctx, contract, "", burn)
+	require.NoError(t, err)
+
+	got, err := env.tfKeeper.Denom.Get(env.ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(70), got.Supply)
+	require.Equal(t, math.NewInt(70), env.bankKeeper.GetSupply(env.ctx, denom).Amount)
+	require.Equal(t, math.NewInt(70), env.bankKeeper.GetBalance(env.ctx, contract, denom).Amount)
+
+	require.Len(t, wrapped.msgs, 1)
+	require.Equal(t, wasmvmtypes.Array[wasmvmtypes.Coin]{wasmvmtypes.NewCoin(5, "stake")}, wrapped.msgs[0].Bank.Burn.Amount)
+
+	_, broken := keeper.SupplyInvariant(env.tfKeeper)(env.ctx)
+	require.False(t, broken)
+}
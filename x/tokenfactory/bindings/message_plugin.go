@@ -0,0 +1,236 @@
+package bindings
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// CustomMessageDecorator returns a decorator that handles TokenFactoryMsg and
+// BankMsg::Burn of factory denoms, and passes every other message on to the
+// wrapped messenger.
+func CustomMessageDecorator(k keeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:   old,
+			msgServer: keeper.NewMsgServerImpl(k),
+		}
+	}
+}
+
+// CustomMessenger dispatches TokenFactoryMsg to the tokenfactory msg server
+// with the contract as signer.
+type CustomMessenger struct {
+	wrapped   wasmkeeper.Messenger
+	msgServer types.MsgServer
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes a custom TokenFactoryMsg or hands msg to the wrapped
+// messenger.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Bank != nil && msg.Bank.Burn != nil {
+		return m.bankBurn(ctx, contractAddr, contractIBCPortID, msg.Bank.Burn)
+	}
+
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var tfMsg TokenFactoryMsg
+	if err := json.Unmarshal(msg.Custom, &tfMsg); err != nil {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var (
+		res  proto.Message
+		data []byte
+		err  error
+	)
+	contract := contractAddr.String()
+	switch {
+	case tfMsg.CreateDenom != nil:
+		res, data, err = m.createDenom(ctx, contract, tfMsg.CreateDenom)
+	case tfMsg.MintTokens != nil:
+		res, err = m.mintTokens(ctx, contract, tfMsg.MintTokens)
+	case tfMsg.BurnTokens != nil:
+		res, err = m.burnTokens(ctx, contract, tfMsg.BurnTokens)
+	case tfMsg.ChangeAdmin != nil:
+		res, err = m.changeAdmin(ctx, contract, tfMsg.ChangeAdmin)
+	case tfMsg.AcceptAdmin != nil:
+		res, err = m.acceptAdmin(ctx, contract, tfMsg.AcceptAdmin)
+	case tfMsg.SetMetadata != nil:
+		res, err = m.setMetadata(ctx, contract, tfMsg.SetMetadata)
+	default:
+		return nil, nil, nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown tokenfactory message variant"}
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	anyRes, err := codectypes.NewAnyWithValue(res)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return nil, [][]byte{data}, [][]*codectypes.Any{{anyRes}}, nil
+}
+
+// bankBurn burns the factory denoms of a BankMsg::Burn through MsgBurn, so
+// that their tracked supply follows the bank supply, and hands the other coins
+// on to the wrapped messenger. Like the wasmd burn handler it returns no data.
+func (m *CustomMessenger) bankBurn(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, burn *wasmvmtypes.BurnMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	var others wasmvmtypes.Array[wasmvmtypes.Coin]
+	for _, coin := range burn.Amount {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			others = append(others, coin)
+			continue
+		}
+
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s of %s", coin.Amount, coin.Denom)
+		}
+		if _, err := m.burnTokens(ctx, contractAddr.String(), &BurnTokens{Denom: coin.Denom, Amount: amount}); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if len(others) == 0 {
+		return nil, nil, nil, nil
+	}
+
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{Amount: others}}})
+}
+
+func (m *CustomMessenger) createDenom(ctx sdk.Context, contract string, createDenom *CreateDenom) (proto.Message, []byte, error) {
+	msg := &types.MsgCreateDenom{
+		Owner:              contract,
+		Subdenom:           createDenom.Subdenom,
+		Description:        createDenom.Description,
+		Ticker:             createDenom.Ticker,
+		Precision:          createDenom.Precision,
+		Url:                createDenom.Url,
+		MaxSupply:          createDenom.MaxSupply,
+		CanChangeMaxSupply: createDenom.CanChangeMaxSupply,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	res, err := m.msgServer.CreateDenom(ctx, msg)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "creating denom")
+	}
+
+	data, err := json.Marshal(CreateDenomResponse{NewTokenDenom: res.NewTokenDenom})
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, data, nil
+}
+
+func (m *CustomMessenger) mintTokens(ctx sdk.Context, contract string, mint *MintTokens) (proto.Message, error) {
+	msg := &types.MsgMintAndSendTokens{
+		Creator:   contract,
+		Denom:     mint.Denom,
+		Amount:    mint.Amount,
+		Recipient: mint.MintToAddress,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := m.msgServer.MintAndSendTokens(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "minting tokens")
+	}
+
+	return res, nil
+}
+
+func (m *CustomMessenger) burnTokens(ctx sdk.Context, contract string, burn *BurnTokens) (proto.Message, error) {
+	msg := &types.MsgBurn{
+		Creator: contract,
+		Denom:   burn.Denom,
+		Amount:  burn.Amount,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := m.msgServer.Burn(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "burning tokens")
+	}
+
+	return res, nil
+}
+
+func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contract string, changeAdmin *ChangeAdmin) (proto.Message, error) {
+	msg := &types.MsgProposeOwner{
+		Creator:  contract,
+		Denom:    changeAdmin.Denom,
+		NewOwner: changeAdmin.NewAdminAddress,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := m.msgServer.ProposeOwner(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "changing admin")
+	}
+
+	return res, nil
+}
+
+func (m *CustomMessenger) acceptAdmin(ctx sdk.Context, contract string, acceptAdmin *AcceptAdmin) (proto.Message, error) {
+	msg := &types.MsgAcceptOwnership{
+		NewOwner: contract,
+		Denom:    acceptAdmin.Denom,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := m.msgServer.AcceptOwnership(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "accepting admin")
+	}
+
+	return res, nil
+}
+
+func (m *CustomMessenger) setMetadata(ctx sdk.Context, contract string, setMetadata *SetMetadata) (proto.Message, error) {
+	msg := &types.MsgUpdateDenom{
+		Owner:              contract,
+		Denom:              setMetadata.Denom,
+		Description:        setMetadata.Description,
+		Url:                setMetadata.Url,
+		MaxSupply:          setMetadata.MaxSupply,
+		CanChangeMaxSupply: setMetadata.CanChangeMaxSupply,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := m.msgServer.UpdateDenom(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "setting metadata")
+	}
+
+	return res, nil
+}
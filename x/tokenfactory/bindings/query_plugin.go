@@ -0,0 +1,71 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// CustomQuerier answers TokenFactoryQuery from contracts.
+func CustomQuerier(k keeper.Keeper) wasmkeeper.CustomQuerier {
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query TokenFactoryQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var denom string
+		switch {
+		case query.DenomInfo != nil:
+			denom = query.DenomInfo.Denom
+		case query.Admin != nil:
+			denom = query.Admin.Denom
+		case query.Supply != nil:
+			denom = query.Supply.Denom
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown tokenfactory query variant"}
+		}
+
+		res, err := queryServer.GetDenom(ctx, &types.QueryGetDenomRequest{Denom: denom})
+		if err != nil {
+			return nil, err
+		}
+
+		var out any
+		switch {
+		case query.DenomInfo != nil:
+			out = DenomInfoResponse{
+				Denom:              res.Denom.Denom,
+				Admin:              res.Denom.Owner,
+				Description:        res.Denom.Description,
+				Ticker:             res.Denom.Ticker,
+				Precision:          res.Denom.Precision,
+				Url:                res.Denom.Url,
+				MaxSupply:          res.Denom.MaxSupply,
+				Supply:             res.Denom.Supply,
+				CanChangeMaxSupply: res.Denom.CanChangeMaxSupply,
+				Paused:             res.Denom.Paused,
+			}
+		case query.Admin != nil:
+			out = AdminResponse{Admin: res.Denom.Owner}
+		case query.Supply != nil:
+			out = SupplyResponse{Supply: res.Denom.Supply, MaxSupply: res.Denom.MaxSupply}
+		}
+
+		bz, err := json.Marshal(out)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, nil
+	}
+}
@@ -0,0 +1,159 @@
+;; tokenfactory_caller is a minimal CosmWasm contract for the binding tests.
+;; execute wraps its message in a custom CosmosMsg and query forwards its
+;; message as a custom QueryRequest, so the tests drive every binding through
+;; the real VM. Build with: wat2wasm tokenfactory_caller.wat
+(module
+  (import "env" "query_chain" (func $query_chain (param i32) (result i32)))
+
+  (memory (export "memory") 16)
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; {"ok":{"messages":[],"attributes":[],"events":[]}}
+  (data (i32.const 0) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[]}}")
+  ;; {"ok":{"messages":[{"id":0,"msg":{"custom":
+  (data (i32.const 64) "{\"ok\":{\"messages\":[{\"id\":0,\"msg\":{\"custom\":")
+  ;; },"reply_on":"never"}],"attributes":[],"events":[]}}
+  (data (i32.const 128) "},\"reply_on\":\"never\"}],\"attributes\":[],\"events\":[]}}")
+  ;; {"custom":
+  (data (i32.const 192) "{\"custom\":")
+  ;; {"ok":
+  (data (i32.const 208) "{\"ok\":")
+  ;; }
+  (data (i32.const 224) "}")
+
+  (func (export "interface_version_8"))
+
+  ;; allocate returns a Region {offset, capacity, length} followed by its data.
+  (func $allocate (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    global.get $heap
+    local.set $region
+    global.get $heap
+    i32.const 12
+    i32.add
+    local.get $size
+    i32.add
+    global.set $heap
+    local.get $region
+    local.get $region
+    i32.const 12
+    i32.add
+    i32.store offset=0
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    local.get $region)
+
+  ;; Memory is never reused, every call runs in a fresh instance.
+  (func (export "deallocate") (param $region i32))
+
+  ;; copy copies len bytes from src to dst and returns the end of dst.
+  (func $copy (param $dst i32) (param $src i32) (param $len i32) (result i32)
+    block
+      loop
+        local.get $len
+        i32.eqz
+        br_if 1
+        local.get $dst
+        local.get $src
+        i32.load8_u offset=0
+        i32.store8 offset=0
+        local.get $dst
+        i32.const 1
+        i32.add
+        local.set $dst
+        local.get $src
+        i32.const 1
+        i32.add
+        local.set $src
+        local.get $len
+        i32.const 1
+        i32.sub
+        local.set $len
+        br 0
+      end
+    end
+    local.get $dst)
+
+  ;; concat returns a new Region holding a, b and c.
+  (func $concat (param $a i32) (param $alen i32) (param $b i32) (param $blen i32) (param $c i32) (param $clen i32) (result i32)
+    (local $region i32)
+    (local $total i32)
+    local.get $alen
+    local.get $blen
+    i32.add
+    local.get $clen
+    i32.add
+    local.set $total
+    local.get $total
+    call $allocate
+    local.set $region
+    local.get $region
+    i32.load offset=0
+    local.get $a
+    local.get $alen
+    call $copy
+    local.get $b
+    local.get $blen
+    call $copy
+    local.get $c
+    local.get $clen
+    call $copy
+    drop
+    local.get $region
+    local.get $total
+    i32.store offset=8
+    local.get $region)
+
+  (func (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 0
+    i32.const 50
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    i32.const 0
+    call $concat)
+
+  ;; execute dispatches msg as a custom message.
+  (func (export "execute") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 64
+    i32.const 43
+    local.get $msg
+    i32.load offset=0
+    local.get $msg
+    i32.load offset=8
+    i32.const 128
+    i32.const 52
+    call $concat)
+
+  ;; query sends msg as a custom query and unwraps {"ok":{"ok":<data>}}
+  ;; into {"ok":<data>}.
+  (func (export "query") (param $env i32) (param $msg i32) (result i32)
+    (local $res i32)
+    i32.const 192
+    i32.const 10
+    local.get $msg
+    i32.load offset=0
+    local.get $msg
+    i32.load offset=8
+    i32.const 224
+    i32.const 1
+    call $concat
+    call $query_chain
+    local.set $res
+    i32.const 208
+    i32.const 6
+    local.get $res
+    i32.load offset=0
+    i32.const 12
+    i32.add
+    local.get $res
+    i32.load offset=8
+    i32.const 14
+    i32.sub
+    i32.const 224
+    i32.const 1
+    call $concat))
@@ -0,0 +1,118 @@
+package bindings
+
+import "cosmossdk.io/math"
+
+// TokenFactoryMsg is the custom message a contract sends as
+// {"custom": {...}}. Exactly one field must be set.
+type TokenFactoryMsg struct {
+	// CreateDenom creates factory/{contract}/{subdenom} owned by the contract.
+	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
+	// MintTokens mints tokens of a denom the contract can mint.
+	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
+	// BurnTokens burns tokens from the balance of the contract.
+	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
+	// ChangeAdmin proposes a new owner for a denom owned by the contract.
+	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
+	// AcceptAdmin makes the contract the owner of a denom it was proposed for.
+	AcceptAdmin *AcceptAdmin `json:"accept_admin,omitempty"`
+	// SetMetadata updates the description, url and max supply of a denom.
+	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
+}
+
+// CreateDenom mirrors MsgCreateDenom.
+type CreateDenom struct {
+	Subdenom           string   `json:"subdenom"`
+	Description        string   `json:"description,omitempty"`
+	Ticker             string   `json:"ticker,omitempty"`
+	Precision          int64    `json:"precision,omitempty"`
+	Url                string   `json:"url,omitempty"`
+	MaxSupply          math.Int `json:"max_supply"`
+	CanChangeMaxSupply bool     `json:"can_change_max_supply,omitempty"`
+}
+
+// CreateDenomResponse is the data returned by CreateDenom.
+type CreateDenomResponse struct {
+	NewTokenDenom string `json:"new_token_denom"`
+}
+
+// MintTokens mirrors MsgMintAndSendTokens.
+type MintTokens struct {
+	Denom         string   `json:"denom"`
+	Amount        math.Int `json:"amount"`
+	MintToAddress string   `json:"mint_to_address"`
+}
+
+// BurnTokens mirrors MsgBurn.
+type BurnTokens struct {
+	Denom  string   `json:"denom"`
+	Amount math.Int `json:"amount"`
+}
+
+// ChangeAdmin mirrors MsgProposeOwner without an expiry.
+type ChangeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+}
+
+// AcceptAdmin mirrors MsgAcceptOwnership.
+type AcceptAdmin struct {
+	Denom string `json:"denom"`
+}
+
+// SetMetadata mirrors MsgUpdateDenom.
+type SetMetadata struct {
+	Denom              string   `json:"denom"`
+	Description        string   `json:"description,omitempty"`
+	Url                string   `json:"url,omitempty"`
+	MaxSupply          math.Int `json:"max_supply"`
+	CanChangeMaxSupply bool     `json:"can_change_max_supply,omitempty"`
+}
+
+// TokenFactoryQuery is the custom query a contract sends as
+// {"custom": {...}}. Exactly one field must be set.
+type TokenFactoryQuery struct {
+	DenomInfo *DenomInfo `json:"denom_info,omitempty"`
+	Admin     *Admin     `json:"admin,omitempty"`
+	Supply    *Supply    `json:"supply,omitempty"`
+}
+
+// DenomInfo queries everything stored about a denom.
+type DenomInfo struct {
+	Denom string `json:"denom"`
+}
+
+// DenomInfoResponse is the response to DenomInfo.
+type DenomInfoResponse struct {
+	Denom              string   `json:"denom"`
+	Admin              string   `json:"admin"`
+	Description        string   `json:"description"`
+	Ticker             string   `json:"ticker"`
+	Precision          int64    `json:"precision"`
+	Url                string   `json:"url"`
+	MaxSupply          math.Int `json:"max_supply"`
+	Supply             math.Int `json:"supply"`
+	CanChangeMaxSupply bool     `json:"can_change_max_supply"`
+	Paused             bool     `json:"paused"`
+}
+
+// Admin queries the owner of a denom.
+type Admin struct {
+	Denom string `json:"denom"`
+}
+
+// AdminResponse is the response to Admin. Admin is empty once ownership was
+// renounced.
+type AdminResponse struct {
+	Admin string `json:"admin"`
+}
+
+// Supply queries the current and maximum supply of a denom.
+type Supply struct {
+	Denom string `json:"denom"`
+}
+
+// SupplyResponse is the response to Supply.
+type SupplyResponse struct {
+	Supply    math.Int `json:"supply"`
+	MaxSupply math.Int `json:"max_supply"`
+}
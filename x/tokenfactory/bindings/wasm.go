@@ -0,0 +1,23 @@
+package bindings
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"nimo-chain/x/tokenfactory/keeper"
+)
+
+// Capability lets contracts that send TokenFactoryMsg declare
+// requires_tokenfactory, so they cannot be stored on chains without the
+// bindings.
+const Capability = "tokenfactory"
+
+// RegisterCustomPlugins returns the wasm keeper options that plug the
+// tokenfactory message handler and querier into x/wasm.
+func RegisterCustomPlugins(k keeper.Keeper) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: CustomQuerier(k),
+		}),
+		wasmkeeper.WithMessageHandlerDecorator(CustomMessageDecorator(k)),
+	}
+}
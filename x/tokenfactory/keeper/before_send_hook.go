@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// callBeforeSendHook calls the before-send hook contract of coin's denom, if
+// any, through sudo. The call runs on its own gas meter capped at
+// BeforeSendHookGasLimit; the gas it used is charged to ctx afterwards. Its
+// writes are kept only if it accepts the transfer. A contract that was
+// migrated to a code ID the params do not allow rejects every transfer.
+func (k Keeper) callBeforeSendHook(ctx context.Context, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) (err error) {
+	if k.wasmKeeper == nil {
+		return nil
+	}
+
+	contract, err := k.BeforeSendHook.Get(ctx, coin.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	contractAddr, err := k.addressCodec.StringToBytes(contract)
+	if err != nil {
+		return err
+	}
+
+	// The admin of the contract can migrate it after the hook was set
+	info := k.wasmKeeper.GetContractInfo(ctx, contractAddr)
+	if info == nil {
+		return errorsmod.Wrapf(types.ErrBeforeSendHook, "hook %s not found", contract)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.IsHookCodeIDAllowed(info.CodeID) {
+		return errorsmod.Wrapf(types.ErrBeforeSendHook, "hook %s runs code id %d, which is not allowed", contract, info.CodeID)
+	}
+
+	from, err := k.addressCodec.BytesToString(fromAddr)
+	if err != nil {
+		return err
+	}
+	to, err := k.addressCodec.BytesToString(toAddr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(types.BlockBeforeSendSudoMsg{
+		BlockBeforeSend: types.BlockBeforeSend{From: from, To: to, Amount: coin},
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	hookCtx := cacheCtx.WithGasMeter(storetypes.NewGasMeter(types.BeforeSendHookGasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(types.ErrBeforeSendHook, "hook %s ran out of gas", contract)
+		}
+		sdkCtx.GasMeter().ConsumeGas(hookCtx.GasMeter().GasConsumedToLimit(), "tokenfactory before send hook")
+	}()
+
+	if _, err := k.wasmKeeper.Sudo(hookCtx, contractAddr, msg); err != nil {
+		return errorsmod.Wrapf(types.ErrBeforeSendHook, "hook %s: %s", contract, err)
+	}
+	write()
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// chargeDenomCreationFee consumes the extra creation gas and collects the
+// creation fee from owner, either burning it or funding the community pool.
+func (k Keeper) chargeDenomCreationFee(ctx context.Context, params types.Params, owner sdk.AccAddress) error {
+	if params.DenomCreationGasConsume > 0 {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(params.DenomCreationGasConsume, "consume denom creation gas")
+	}
+
+	fee := params.DenomCreationFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	if !params.BurnDenomCreationFee {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, owner); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, fmt.Sprintf("failed to pay denom creation fee: %s", err))
+		}
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, fee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, fmt.Sprintf("failed to pay denom creation fee: %s", err))
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to burn denom creation fee: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// DenomIndexes defines the secondary indexes of the Denom map.
+type DenomIndexes struct {
+	// Owner indexes denoms by (owner, denom).
+	Owner *indexes.Multi[string, string, types.Denom]
+}
+
+// IndexesList implements collections.Indexes.
+func (i DenomIndexes) IndexesList() []collections.Index[string, types.Denom] {
+	return []collections.Index[string, types.Denom]{i.Owner}
+}
+
+// NewDenomIndexes returns the indexes kept in sync with the Denom map.
+func NewDenomIndexes(sb *collections.SchemaBuilder) DenomIndexes {
+	return DenomIndexes{
+		Owner: indexes.NewMulti(sb, types.DenomOwnerIndexKey, "denom_by_owner",
+			collections.StringKey, collections.StringKey,
+			func(_ string, denom types.Denom) (string, error) {
+				return denom.Owner, nil
+			},
+		),
+	}
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// setDenomMetadata writes the bank metadata of the denom so that wallets,
+// explorers and the textual sign mode see its ticker and decimals.
+func (k Keeper) setDenomMetadata(ctx context.Context, denom types.Denom) error {
+	metadata, err := denom.BankMetadata()
+	if err != nil {
+		return err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// removeDenomMetadata removes the bank metadata of a deleted denom, so that
+// it no longer describes a token that cannot be minted.
+func (k Keeper) removeDenomMetadata(ctx context.Context, denom string) error {
+	if k.denomMetadataKeeper == nil {
+		return nil
+	}
+
+	return k.denomMetadataKeeper.RemoveDenomMetaData(ctx, denom)
+}
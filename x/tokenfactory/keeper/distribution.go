@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// distributionPruneLimit caps the holder balances and claims of expired
+// distributions deleted per block.
+const distributionPruneLimit = 1000
+
+// recordDistributionBalances records the current balance of denom of every
+// address for each active distribution of denom that has no balance or claim
+// for it yet. It runs before every transfer, so a recorded balance is the
+// balance at the creation of the distribution.
+func (k Keeper) recordDistributionBalances(ctx context.Context, denom string, addrs ...sdk.AccAddress) error {
+	var ids []uint64
+	if err := k.ActiveDistribution.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](denom), func(key collections.Pair[string, uint64]) (bool, error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		address, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			key := collections.Join(id, address)
+			recorded, err := k.DistributionBalance.Has(ctx, key)
+			if err != nil {
+				return err
+			}
+			claimed, err := k.DistributionClaim.Has(ctx, key)
+			if err != nil {
+				return err
+			}
+			if recorded || claimed {
+				continue
+			}
+
+			if err := k.DistributionBalance.Set(ctx, key, k.bankKeeper.GetBalance(ctx, addr, denom).Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// holderSupply returns the supply of denom minus the balances of module
+// accounts and ICS-20 escrow accounts, which can never claim a distribution.
+// Shares are computed against it so that those balances do not dilute holders.
+func (k Keeper) holderSupply(ctx context.Context, denom string) (math.Int, error) {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	for _, perms := range k.authKeeper.GetModulePermissions() {
+		supply = supply.Sub(k.bankKeeper.GetBalance(ctx, perms.GetAddress(), denom).Amount)
+	}
+
+	err := k.EscrowAddress.Walk(ctx, nil, func(escrow sdk.AccAddress) (bool, error) {
+		supply = supply.Sub(k.bankKeeper.GetBalance(ctx, escrow, denom).Amount)
+		return false, nil
+	})
+
+	return supply, err
+}
+
+// distributionShare returns the rewards address can claim from distribution,
+// rounded down and capped at the remaining rewards.
+func (k Keeper) distributionShare(ctx context.Context, distribution types.Distribution, address string) (sdk.Coins, error) {
+	balance, err := k.DistributionBalance.Get(ctx, collections.Join(distribution.Id, address))
+	if errors.Is(err, collections.ErrNotFound) {
+		// The balance did not change since the distribution was created
+		addr, err := k.addressCodec.StringToBytes(address)
+		if err != nil {
+			return nil, err
+		}
+		balance = k.bankKeeper.GetBalance(ctx, addr, distribution.Denom).Amount
+	} else if err != nil {
+		return nil, err
+	}
+
+	share := sdk.NewCoins()
+	for _, reward := range distribution.Rewards {
+		amount := reward.Amount.Mul(balance).Quo(distribution.Supply)
+		share = share.Add(sdk.NewCoin(reward.Denom, amount))
+	}
+
+	return share.Min(distribution.Remaining), nil
+}
+
+// ProcessExpiredDistributions returns the unclaimed rewards of every
+// distribution whose expiry is not after the current block time to its
+// creator. Each refund runs in its own cache context, and a distribution whose
+// refund fails is logged and kept until a later block. The holder balances and
+// claims of refunded distributions are then deleted, at most
+// distributionPruneLimit per block, and a distribution is removed once none
+// are left.
+func (k Keeper) ProcessExpiredDistributions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	// Collect first so the queue is not written while it is being iterated
+	var expired []collections.Pair[time.Time, uint64]
+	err := k.DistributionExpiryQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](blockTime), func(key collections.Pair[time.Time, uint64]) (stop bool, err error) {
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// A distribution whose refund fails is kept and retried in later blocks
+	// rather than halting the chain
+	var refunded []collections.Pair[time.Time, uint64]
+	for _, key := range expired {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.expireDistribution(cacheCtx, key.K2()); err != nil {
+			sdkCtx.Logger().Error("failed to expire distribution", "module", types.ModuleName, "id", key.K2(), "error", err)
+			continue
+		}
+		write()
+		refunded = append(refunded, key)
+	}
+
+	budget := distributionPruneLimit
+	for _, key := range refunded {
+		done, err := k.pruneDistribution(ctx, key.K2(), &budget)
+		if err != nil {
+			return err
+		}
+		if !done {
+			break
+		}
+
+		if err := k.DistributionExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.Distribution.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireDistribution returns the unclaimed rewards of a distribution to its
+// creator and stops recording balances for it.
+func (k Keeper) expireDistribution(ctx context.Context, id uint64) error {
+	distribution, err := k.Distribution.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.ActiveDistribution.Remove(ctx, collections.Join(distribution.Denom, id)); err != nil {
+		return err
+	}
+	if distribution.Remaining.IsZero() {
+		return nil
+	}
+
+	refunded := distribution.Remaining
+	creator, err := k.addressCodec.StringToBytes(distribution.Creator)
+	if err != nil {
+		return err
+	}
+	// The refund only returns escrowed rewards, so the send restriction of the
+	// reward denoms does not apply
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(withForceTransfer(ctx), types.ModuleName, creator, refunded); err != nil {
+		return err
+	}
+
+	distribution.Remaining = nil
+	if err := k.Distribution.Set(ctx, id, distribution); err != nil {
+		return err
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.EventDistributionExpired{
+		DistributionId: id,
+		Creator:        distribution.Creator,
+		Refunded:       refunded,
+	})
+}
+
+// pruneDistribution deletes holder balances and claims of a distribution
+// until the budget runs out, and reports whether none are left.
+func (k Keeper) pruneDistribution(ctx context.Context, id uint64, budget *int) (bool, error) {
+	rng := collections.NewPrefixedPairRange[uint64, string](id)
+
+	var balances []collections.Pair[uint64, string]
+	if err := k.DistributionBalance.Walk(ctx, rng, func(key collections.Pair[uint64, string], _ math.Int) (bool, error) {
+		if len(balances) == *budget {
+			return true, nil
+		}
+		balances = append(balances, key)
+		return false, nil
+	}); err != nil {
+		return false, err
+	}
+	for _, key := range balances {
+		if err := k.DistributionBalance.Remove(ctx, key); err != nil {
+			return false, err
+		}
+	}
+	*budget -= len(balances)
+
+	var claims []collections.Pair[uint64, string]
+	if err := k.DistributionClaim.Walk(ctx, rng, func(key collections.Pair[uint64, string]) (bool, error) {
+		if len(claims) == *budget {
+			return true, nil
+		}
+		claims = append(claims, key)
+		return false, nil
+	}); err != nil {
+		return false, err
+	}
+	for _, key := range claims {
+		if err := k.DistributionClaim.Remove(ctx, key); err != nil {
+			return false, err
+		}
+	}
+	*budget -= len(claims)
+
+	if *budget > 0 {
+		return true, nil
+	}
+
+	// The budget ran out exactly; only done if nothing is left
+	hasBalance, err := k.DistributionBalance.Iterate(ctx, rng)
+	if err != nil {
+		return false, err
+	}
+	defer hasBalance.Close()
+	hasClaim, err := k.DistributionClaim.Iterate(ctx, rng)
+	if err != nil {
+		return false, err
+	}
+	defer hasClaim.Close()
+
+	return !hasBalance.Valid() && !hasClaim.Valid(), nil
+}
+
+// setDistribution stores distribution and queues it for expiry. It stays
+// active, recording holder balances, while it has unclaimed rewards.
+func (k Keeper) setDistribution(ctx context.Context, distribution types.Distribution) error {
+	if err := k.Distribution.Set(ctx, distribution.Id, distribution); err != nil {
+		return err
+	}
+
+	if !distribution.Remaining.IsZero() {
+		if err := k.ActiveDistribution.Set(ctx, collections.Join(distribution.Denom, distribution.Id)); err != nil {
+			return err
+		}
+	}
+
+	return k.DistributionExpiryQueue.Set(ctx, collections.Join(distribution.Expiry, distribution.Id))
+}
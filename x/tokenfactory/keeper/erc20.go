@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// registerERC20 registers a native coin ERC-20 token pair for denom and
+// returns the hex address of its contract. The ERC-20 precompile reads the
+// name, symbol and decimals from the bank metadata of the denom, so the
+// metadata must be set first. It returns an empty address when registration
+// is turned off, the denom opted out or the denom already has a pair, and
+// fails if a contract is already deployed at the address.
+func (k Keeper) registerERC20(ctx context.Context, params types.Params, denom string, optOut bool) (string, error) {
+	if k.erc20Keeper == nil || !params.EnableErc20Registration || optOut {
+		return "", nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.erc20Keeper.IsERC20Enabled(sdkCtx) || k.erc20Keeper.IsDenomRegistered(sdkCtx, denom) {
+		return "", nil
+	}
+
+	address := types.ERC20Address(denom)
+	if k.evmKeeper != nil {
+		if account := k.evmKeeper.GetAccount(sdkCtx, address); account != nil && account.IsContract() {
+			return "", errorsmod.Wrapf(erc20types.ErrTokenPairAlreadyExists, "contract already exists at ERC-20 address %s", address)
+		}
+	}
+
+	pair := erc20types.NewTokenPair(address, denom, erc20types.OWNER_MODULE)
+	if err := k.erc20Keeper.SetToken(sdkCtx, pair); err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to register ERC-20 token pair: %s", err)
+	}
+
+	if err := k.erc20Keeper.EnableDynamicPrecompile(sdkCtx, address); err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to enable ERC-20 precompile: %s", err)
+	}
+
+	return address.Hex(), nil
+}
+
+// removeERC20 deletes the ERC-20 token pair that registerERC20 made for denom
+// and disables its precompile, which would otherwise outlive the denom and
+// keep it from getting a pair again if it is recreated. Pairs registered
+// outside the module are left alone.
+func (k Keeper) removeERC20(ctx context.Context, denom string) error {
+	if k.erc20Keeper == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pair, found := k.erc20Keeper.GetTokenPair(sdkCtx, k.erc20Keeper.GetTokenPairID(sdkCtx, denom))
+	address := types.ERC20Address(denom)
+	if !found || pair.GetERC20Contract() != address || pair.ContractOwner != erc20types.OWNER_MODULE {
+		return nil
+	}
+
+	k.erc20Keeper.DeleteTokenPair(sdkCtx, pair)
+	k.erc20Keeper.DeleteDynamicPrecompile(sdkCtx, address)
+
+	return k.erc20Keeper.UnRegisterERC20CodeHash(sdkCtx, address)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// FeeDenomRate returns the amount of the base fee denom that one unit of a fee
+// denom is worth. Oracle priced denoms use the time weighted average price over
+// their TWAP window, where each price holds until the next one is submitted.
+// The rate is unavailable when no price was submitted within the window.
+func (k Keeper) FeeDenomRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	feeDenom, err := k.FeeDenom.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, errorsmod.Wrap(types.ErrNotFeeDenom, denom)
+		}
+		return math.LegacyDec{}, err
+	}
+
+	if len(feeDenom.Oracles) == 0 {
+		return feeDenom.FixedRate, nil
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	windowStart := now.Add(-time.Duration(feeDenom.TwapWindow) * time.Second)
+
+	// The latest price before the window holds at its start
+	start, price, err := k.latestFeeDenomPrice(ctx, denom, windowStart)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, err
+	}
+	hasPrice := err == nil
+	if hasPrice {
+		start = windowStart
+	}
+
+	rng := collections.NewPrefixedPairRange[string, time.Time](denom).StartExclusive(windowStart).EndInclusive(now)
+	iter, err := k.FeeDenomPrice.Iterate(ctx, rng)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	defer iter.Close()
+
+	var (
+		weighted = math.LegacyZeroDec()
+		last     = start
+		inWindow bool
+	)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		if hasPrice {
+			weighted = weighted.Add(price.MulInt64(int64(kv.Key.K2().Sub(last))))
+		} else {
+			start, hasPrice = kv.Key.K2(), true
+		}
+		last, price, inWindow = kv.Key.K2(), kv.Value, true
+	}
+
+	if !inWindow {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrStaleFeeRate, "%s within the last %ds", denom, feeDenom.TwapWindow)
+	}
+
+	weighted = weighted.Add(price.MulInt64(int64(now.Sub(last))))
+	if elapsed := now.Sub(start); elapsed > 0 {
+		return weighted.QuoInt64(int64(elapsed)), nil
+	}
+
+	return price, nil
+}
+
+// latestFeeDenomPrice returns the latest price of a fee denom submitted at or
+// before the given time, along with its submission time.
+func (k Keeper) latestFeeDenomPrice(ctx context.Context, denom string, at time.Time) (time.Time, math.LegacyDec, error) {
+	rng := collections.NewPrefixedPairRange[string, time.Time](denom).EndInclusive(at).Descending()
+
+	iter, err := k.FeeDenomPrice.Iterate(ctx, rng)
+	if err != nil {
+		return time.Time{}, math.LegacyDec{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return time.Time{}, math.LegacyDec{}, collections.ErrNotFound
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return time.Time{}, math.LegacyDec{}, err
+	}
+
+	return kv.Key.K2(), kv.Value, nil
+}
+
+// pruneFeeDenomPrices drops the prices of a fee denom that no longer affect its
+// TWAP, keeping the latest one before the window since it holds at its start.
+func (k Keeper) pruneFeeDenomPrices(ctx context.Context, feeDenom types.FeeDenom) error {
+	windowStart := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-time.Duration(feeDenom.TwapWindow) * time.Second)
+
+	at, _, err := k.latestFeeDenomPrice(ctx, feeDenom.Denom, windowStart)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.FeeDenomPrice.Clear(ctx, collections.NewPrefixedPairRange[string, time.Time](feeDenom.Denom).EndExclusive(at))
+}
+
+// removeFeeDenom stops accepting a denom for transaction fees and drops its
+// prices.
+func (k Keeper) removeFeeDenom(ctx context.Context, denom string) error {
+	if err := k.FeeDenom.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.FeeDenomPrice.Clear(ctx, collections.NewPrefixedPairRange[string, time.Time](denom))
+}
+
+// ConvertFee swaps a transaction fee paid in a fee denom for its value in
+// baseDenom at the rate of the fee denom, rounded down. The owner of the denom
+// backs the swap: it receives the fee from the payer and pays the value from
+// its own balance into the fee collector, so validators are only ever paid in
+// baseDenom.
+func (k Keeper) ConvertFee(ctx context.Context, payer sdk.AccAddress, fee sdk.Coin, baseDenom string) (sdk.Coin, error) {
+	rate, err := k.FeeDenomRate(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	value := sdk.NewCoin(baseDenom, rate.MulInt(fee.Amount).TruncateInt())
+	if !value.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "%s is worth no %s", fee, baseDenom)
+	}
+
+	denom, err := k.Denom.Get(ctx, fee.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if denom.Owner == "" {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "denom %s has no owner to convert fees", fee.Denom)
+	}
+	owner, err := k.addressCodec.StringToBytes(denom.Owner)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, payer, owner, sdk.NewCoins(fee)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, sdk.NewCoins(value)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "owner of %s cannot convert the fee: %s", fee.Denom, err)
+	}
+
+	return value, nil
+}
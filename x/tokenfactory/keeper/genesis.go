@@ -2,6 +2,11 @@ package keeper
 
 import (
 	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"nimo-chain/x/tokenfactory/types"
 )
@@ -13,6 +18,78 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 			return err
 		}
 	}
+	for _, elem := range genState.BurnAllowances {
+		if err := k.BurnAllowance.Set(ctx, collections.Join(elem.Denom, elem.Holder), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.FrozenAccounts {
+		if err := k.FrozenAccount.Set(ctx, collections.Join(elem.Denom, elem.Address)); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.RoleGrants {
+		if err := k.RoleGrant.Set(ctx, collections.Join(elem.Denom, elem.Address), elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.EpochMints {
+		if err := k.EpochMint.Set(ctx, collections.Join(elem.Denom, elem.Minter), elem.Amount); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.OwnershipProposals {
+		if err := k.setOwnershipProposal(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.BeforeSendHooks {
+		if err := k.BeforeSendHook.Set(ctx, elem.Denom, elem.ContractAddress); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.SupplyCheckpoints {
+		if err := k.SupplyCheckpoint.Set(ctx, collections.Join(elem.Denom, elem.Height), elem.Supply); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.FeeDenoms {
+		if err := k.FeeDenom.Set(ctx, elem.Denom, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.FeeDenomPrices {
+		if err := k.FeeDenomPrice.Set(ctx, collections.Join(elem.Denom, elem.Time), elem.Price); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.Distributions {
+		if err := k.setDistribution(ctx, elem); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.DistributionBalances {
+		if err := k.DistributionBalance.Set(ctx, collections.Join(elem.DistributionId, elem.Address), elem.Amount); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.DistributionClaims {
+		if err := k.DistributionClaim.Set(ctx, collections.Join(elem.DistributionId, elem.Address)); err != nil {
+			return err
+		}
+	}
+	for _, elem := range genState.EscrowAddresses {
+		addr, err := k.addressCodec.StringToBytes(elem)
+		if err != nil {
+			return err
+		}
+		if err := k.EscrowAddress.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+	if err := k.DistributionSeq.Set(ctx, genState.DistributionCount); err != nil {
+		return err
+	}
 
 	return k.Params.Set(ctx, genState.Params)
 }
@@ -32,6 +109,92 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 	}); err != nil {
 		return nil, err
 	}
+	if err := k.BurnAllowance.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.BurnAllowance) (stop bool, err error) {
+		genesis.BurnAllowances = append(genesis.BurnAllowances, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.FrozenAccount.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		genesis.FrozenAccounts = append(genesis.FrozenAccounts, types.FrozenAccount{Denom: key.K1(), Address: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.RoleGrant.Walk(ctx, nil, func(_ collections.Pair[string, string], val types.RoleGrant) (stop bool, err error) {
+		genesis.RoleGrants = append(genesis.RoleGrants, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.EpochMint.Walk(ctx, nil, func(key collections.Pair[string, string], amount math.Int) (stop bool, err error) {
+		genesis.EpochMints = append(genesis.EpochMints, types.EpochMint{Denom: key.K1(), Minter: key.K2(), Amount: amount})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.OwnershipProposal.Walk(ctx, nil, func(_ string, val types.OwnershipProposal) (stop bool, err error) {
+		genesis.OwnershipProposals = append(genesis.OwnershipProposals, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.BeforeSendHook.Walk(ctx, nil, func(denom string, contract string) (stop bool, err error) {
+		genesis.BeforeSendHooks = append(genesis.BeforeSendHooks, types.BeforeSendHook{Denom: denom, ContractAddress: contract})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.SupplyCheckpoint.Walk(ctx, nil, func(key collections.Pair[string, int64], supply math.Int) (stop bool, err error) {
+		genesis.SupplyCheckpoints = append(genesis.SupplyCheckpoints, types.SupplyCheckpoint{Denom: key.K1(), Height: key.K2(), Supply: supply})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.FeeDenom.Walk(ctx, nil, func(_ string, val types.FeeDenom) (stop bool, err error) {
+		genesis.FeeDenoms = append(genesis.FeeDenoms, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.FeeDenomPrice.Walk(ctx, nil, func(key collections.Pair[string, time.Time], price math.LegacyDec) (stop bool, err error) {
+		genesis.FeeDenomPrices = append(genesis.FeeDenomPrices, types.FeeDenomPrice{Denom: key.K1(), Time: key.K2(), Price: price})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.Distribution.Walk(ctx, nil, func(_ uint64, val types.Distribution) (stop bool, err error) {
+		genesis.Distributions = append(genesis.Distributions, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.DistributionBalance.Walk(ctx, nil, func(key collections.Pair[uint64, string], amount math.Int) (stop bool, err error) {
+		genesis.DistributionBalances = append(genesis.DistributionBalances, types.DistributionBalance{DistributionId: key.K1(), Address: key.K2(), Amount: amount})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.DistributionClaim.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (stop bool, err error) {
+		genesis.DistributionClaims = append(genesis.DistributionClaims, types.DistributionClaim{DistributionId: key.K1(), Address: key.K2()})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.EscrowAddress.Walk(ctx, nil, func(addr sdk.AccAddress) (stop bool, err error) {
+		address, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		genesis.EscrowAddresses = append(genesis.EscrowAddresses, address)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.DistributionCount, err = k.DistributionSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	return genesis, nil
-}
\ No newline at end of file
+}
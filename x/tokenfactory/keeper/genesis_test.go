@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"nimo-chain/testutil/sample"
 	"nimo-chain/x/tokenfactory/types"
 
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,8 @@ func TestGenesis(t *testing.T) {
 		Params: types.DefaultParams(),
 
 		DenomMap: []types.Denom{{Denom: "0"}, {Denom: "1"}},
+
+		EscrowAddresses: []string{sample.AccAddress()},
 	}
 
 	f := initFixture(t)
@@ -24,4 +27,5 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.DenomMap, got.DenomMap)
+	require.Equal(t, genesisState.EscrowAddresses, got.EscrowAddresses)
 }
\ No newline at end of file
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks resets the per-epoch mint counters when a mint epoch ends and records
+// supply checkpoints when a supply checkpoint epoch ends.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the x/epochs hooks of the module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd records a supply checkpoint for every denom once the epoch
+// named by the supply checkpoint epoch identifier param ends, and clears every
+// per-epoch mint counter once the epoch named by the mint epoch identifier
+// param ends.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if epochIdentifier == params.SupplyCheckpointEpochIdentifier {
+		if err := h.k.checkpointSupplies(ctx); err != nil {
+			return err
+		}
+	}
+
+	if epochIdentifier != params.MintEpochIdentifier {
+		return nil
+	}
+
+	return h.k.EpochMint.Clear(ctx, nil)
+}
+
+// BeforeEpochStart is a no-op.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// RegisterInvariants registers the tokenfactory module invariants. The app
+// has no x/crisis, so nothing runs them and tests call SupplyInvariant
+// directly. The tracked supply is kept in sync instead: factory denoms are
+// only minted and burned by the module, and the wasm bindings route
+// BankMsg::Burn of factory denoms through MsgBurn.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+}
+
+// SupplyInvariant checks that the supply tracked for every denom matches the
+// bank total supply and does not exceed its max supply.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		err := k.Denom.Walk(ctx, nil, func(_ string, denom types.Denom) (stop bool, err error) {
+			bankSupply := k.bankKeeper.GetSupply(ctx, denom.Denom).Amount
+			if !denom.Supply.Equal(bankSupply) {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s tracks supply %s but bank supply is %s\n", denom.Denom, denom.Supply, bankSupply)
+			}
+			if denom.Supply.GT(denom.MaxSupply) {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s supply %s exceeds max supply %s\n", denom.Denom, denom.Supply, denom.MaxSupply)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "supply", err.Error()), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "supply", fmt.Sprintf("%d supply mismatches found\n%s", broken, msg)), broken > 0
+	}
+}
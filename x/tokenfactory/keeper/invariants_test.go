@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestSupplyInvariant(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.SupplyInvariant(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(40), Recipient: owner})
+	require.NoError(t, err)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// Supply minted outside the module breaks the invariant
+	f.bankKeeper.supply = f.bankKeeper.supply.Add(sdk.NewInt64Coin(token, 1))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "bank supply is 41")
+
+	// So does a supply above the cap
+	f.bankKeeper.supply = f.bankKeeper.supply.Sub(sdk.NewInt64Coin(token, 1))
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	denom.MaxSupply = math.NewInt(39)
+	require.NoError(t, f.keeper.Denom.Set(f.ctx, token, denom))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "exceeds max supply")
+}
@@ -2,17 +2,22 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"nimo-chain/x/tokenfactory/types"
 )
 
 type Keeper struct {
 	storeService corestore.KVStoreService
+	eventService event.Service
 	cdc          codec.Codec
 	addressCodec address.Codec
 	// Address capable of executing a MsgUpdateParams message.
@@ -22,19 +27,76 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
-	authKeeper types.AuthKeeper
-	bankKeeper types.BankKeeper
-	Denom      collections.Map[string, types.Denom]
+	authKeeper  types.AuthKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
+	// erc20Keeper is nil on chains without the ERC-20 module.
+	erc20Keeper types.Erc20Keeper
+	// evmKeeper is nil on chains without the EVM; ERC-20 addresses are then
+	// not checked for existing contracts.
+	evmKeeper types.EVMKeeper
+	// wasmKeeper is nil on chains without CosmWasm; before-send hooks are
+	// then never called.
+	wasmKeeper types.WasmKeeper
+	// channelKeeper is nil on chains without IBC. It is only read to seed
+	// EscrowAddress with the channels opened before it existed.
+	channelKeeper types.ChannelKeeper
+	// denomMetadataKeeper is nil in tests without x/bank; the metadata of
+	// deleted denoms is then left in place.
+	denomMetadataKeeper types.DenomMetadataKeeper
+	// Denom is indexed by owner, see DenomIndexes.
+	Denom *collections.IndexedMap[string, types.Denom, DenomIndexes]
+	// BurnAllowance is keyed by (denom, holder).
+	BurnAllowance collections.Map[collections.Pair[string, string], types.BurnAllowance]
+	// FrozenAccount is keyed by (denom, address).
+	FrozenAccount collections.KeySet[collections.Pair[string, string]]
+	// RoleGrant is keyed by (denom, address).
+	RoleGrant collections.Map[collections.Pair[string, string], types.RoleGrant]
+	// EpochMint is keyed by (denom, minter) and cleared when a mint epoch ends.
+	EpochMint collections.Map[collections.Pair[string, string], math.Int]
+	// OwnershipProposal is keyed by denom.
+	OwnershipProposal collections.Map[string, types.OwnershipProposal]
+	// OwnershipExpiryQueue orders ownership proposals by (expiry, denom).
+	OwnershipExpiryQueue collections.KeySet[collections.Pair[time.Time, string]]
+	// BeforeSendHook maps a denom to its hook contract address.
+	BeforeSendHook collections.Map[string, string]
+	// SupplyCheckpoint is keyed by (denom, height).
+	SupplyCheckpoint collections.Map[collections.Pair[string, int64], math.Int]
+	// FeeDenom is keyed by denom.
+	FeeDenom collections.Map[string, types.FeeDenom]
+	// FeeDenomPrice is keyed by (denom, submission time).
+	FeeDenomPrice collections.Map[collections.Pair[string, time.Time], math.LegacyDec]
+	// Distribution is keyed by id.
+	Distribution    collections.Map[uint64, types.Distribution]
+	DistributionSeq collections.Sequence
+	// ActiveDistribution holds the (denom, id) of distributions with unclaimed
+	// rewards that have not expired.
+	ActiveDistribution collections.KeySet[collections.Pair[string, uint64]]
+	// DistributionExpiryQueue orders distributions by (expiry, id).
+	DistributionExpiryQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// DistributionBalance is keyed by (id, address).
+	DistributionBalance collections.Map[collections.Pair[uint64, string], math.Int]
+	// DistributionClaim is keyed by (id, address).
+	DistributionClaim collections.KeySet[collections.Pair[uint64, string]]
+	// EscrowAddress holds the ICS-20 escrow accounts, see RecordEscrowAddress.
+	EscrowAddress collections.KeySet[sdk.AccAddress]
 }
 
 func NewKeeper(
 	storeService corestore.KVStoreService,
+	eventService event.Service,
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
 
 	authKeeper types.AuthKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	erc20Keeper types.Erc20Keeper,
+	evmKeeper types.EVMKeeper,
+	wasmKeeper types.WasmKeeper,
+	channelKeeper types.ChannelKeeper,
+	denomMetadataKeeper types.DenomMetadataKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -44,14 +106,56 @@ func NewKeeper(
 
 	k := Keeper{
 		storeService: storeService,
+		eventService: eventService,
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Denom:      collections.NewMap(sb, types.DenomKey, "denom", collections.StringKey, codec.CollValue[types.Denom](cdc)),
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+		erc20Keeper: erc20Keeper,
+		evmKeeper:   evmKeeper,
+		wasmKeeper:  wasmKeeper,
+
+		channelKeeper:       channelKeeper,
+		denomMetadataKeeper: denomMetadataKeeper,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Denom: collections.NewIndexedMap(sb, types.DenomKey, "denom", collections.StringKey,
+			codec.CollValue[types.Denom](cdc), NewDenomIndexes(sb)),
+		BurnAllowance: collections.NewMap(sb, types.BurnAllowanceKey, "burnAllowance",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.BurnAllowance](cdc)),
+		FrozenAccount: collections.NewKeySet(sb, types.FrozenAccountKey, "frozenAccount",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		RoleGrant: collections.NewMap(sb, types.RoleGrantKey, "roleGrant",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RoleGrant](cdc)),
+		EpochMint: collections.NewMap(sb, types.EpochMintKey, "epochMint",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		OwnershipProposal: collections.NewMap(sb, types.OwnershipProposalKey, "ownershipProposal",
+			collections.StringKey, codec.CollValue[types.OwnershipProposal](cdc)),
+		OwnershipExpiryQueue: collections.NewKeySet(sb, types.OwnershipExpiryQueueKey, "ownershipExpiryQueue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		BeforeSendHook: collections.NewMap(sb, types.BeforeSendHookKey, "beforeSendHook",
+			collections.StringKey, collections.StringValue),
+		SupplyCheckpoint: collections.NewMap(sb, types.SupplyCheckpointKey, "supplyCheckpoint",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key), sdk.IntValue),
+		FeeDenom: collections.NewMap(sb, types.FeeDenomKey, "feeDenom",
+			collections.StringKey, codec.CollValue[types.FeeDenom](cdc)),
+		FeeDenomPrice: collections.NewMap(sb, types.FeeDenomPriceKey, "feeDenomPrice",
+			collections.PairKeyCodec(collections.StringKey, sdk.TimeKey), sdk.LegacyDecValue),
+		Distribution: collections.NewMap(sb, types.DistributionKey, "distribution",
+			collections.Uint64Key, codec.CollValue[types.Distribution](cdc)),
+		DistributionSeq: collections.NewSequence(sb, types.DistributionSeqKey, "distributionSeq"),
+		ActiveDistribution: collections.NewKeySet(sb, types.ActiveDistributionKey, "activeDistribution",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		DistributionExpiryQueue: collections.NewKeySet(sb, types.DistributionExpiryQueueKey, "distributionExpiryQueue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		DistributionBalance: collections.NewMap(sb, types.DistributionBalanceKey, "distributionBalance",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue),
+		DistributionClaim: collections.NewKeySet(sb, types.DistributionClaimKey, "distributionClaim",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+		EscrowAddress: collections.NewKeySet(sb, types.EscrowAddressKey, "escrowAddress", sdk.AccAddressKey),
 	}
 
 	schema, err := sb.Build()
@@ -66,4 +170,4 @@ func NewKeeper(
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
-}
\ No newline at end of file
+}
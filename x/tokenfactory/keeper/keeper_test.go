@@ -12,6 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 
 	"nimo-chain/x/tokenfactory/keeper"
 	module "nimo-chain/x/tokenfactory/module"
@@ -19,9 +21,16 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	storeKey      *storetypes.KVStoreKey
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	authKeeper    *mockAuthKeeper
+	bankKeeper    *mockBankKeeper
+	distrKeeper   *mockDistrKeeper
+	erc20Keeper   *mockErc20Keeper
+	wasmKeeper    *mockWasmKeeper
+	channelKeeper *mockChannelKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,14 +44,27 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authKeeper := newMockAuthKeeper(addressCodec)
+	bankKeeper := newMockBankKeeper()
+	distrKeeper := newMockDistrKeeper(bankKeeper)
+	erc20Keeper := newMockErc20Keeper()
+	wasmKeeper := newMockWasmKeeper()
+	channelKeeper := newMockChannelKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
+		runtime.EventService{},
 		encCfg.Codec,
 		addressCodec,
 		authority,
-		nil,
-		nil,
+		authKeeper,
+		bankKeeper,
+		distrKeeper,
+		erc20Keeper,
+		erc20Keeper,
+		wasmKeeper,
+		channelKeeper,
+		bankKeeper,
 	)
 
 	// Initialize params
@@ -51,8 +73,24 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           ctx,
+		storeKey:      storeKey,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		erc20Keeper:   erc20Keeper,
+		wasmKeeper:    wasmKeeper,
+		channelKeeper: channelKeeper,
 	}
 }
+
+// requireEvent checks that event was emitted on ctx.
+func requireEvent(t *testing.T, ctx context.Context, event proto.Message) {
+	t.Helper()
+
+	expected, err := sdk.TypedEventToEvent(event)
+	require.NoError(t, err)
+	require.Contains(t, sdk.UnwrapSDKContext(ctx).EventManager().Events(), expected)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	v2 "nimo-chain/x/tokenfactory/migrations/v2"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 upgrades the version 1 store. It converts the int64 supplies to
+// math.Int, then for every denom indexes it by owner, grants every role to
+// its owner, which keeps owners able to do everything they could before roles
+// existed, and registers its bank metadata. Denoms whose fields cannot form
+// valid metadata are skipped and logged rather than halting the upgrade.
+// Version 1 had no params, so they are set to their defaults, and the escrow
+// accounts of the ICS-20 channels already open are recorded. IBC v2 escrows
+// are recorded by the IBC middleware on their next packet, before any tokens
+// move.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// Version 1 stored the supplies as int64 varints, which the current Denom
+	// schema cannot decode, so the records are converted before being read.
+	if err := v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	// Collect first so the store is not written while it is being iterated
+	var denoms []types.Denom
+	if err := m.keeper.Denom.Walk(ctx, nil, func(_ string, denom types.Denom) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, denom := range denoms {
+		// Setting a denom again references it in every index
+		if err := m.keeper.Denom.Set(ctx, denom.Denom, denom); err != nil {
+			return err
+		}
+		if err := m.keeper.grantAllRoles(ctx, denom.Denom, denom.Owner); err != nil {
+			return err
+		}
+		if err := m.keeper.setDenomMetadata(ctx, denom); err != nil {
+			ctx.Logger().Error("skipping bank metadata for denom", "module", types.ModuleName, "denom", denom.Denom, "error", err)
+		}
+	}
+
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	if m.keeper.channelKeeper == nil {
+		return nil
+	}
+
+	for _, channel := range m.keeper.channelKeeper.GetAllChannelsWithPortPrefix(ctx, ibctransfertypes.PortID) {
+		if err := m.keeper.RecordEscrowAddress(ctx, channel.PortId, channel.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	f.channelKeeper.channels = []channeltypes.IdentifiedChannel{
+		{PortId: ibctransfertypes.PortID, ChannelId: "channel-0"},
+		{PortId: "icahost", ChannelId: "channel-1"},
+	}
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	denom := "factory/" + owner + "/legacy"
+	invalid := "factory/" + owner + "/invalid"
+
+	// Version 1 encoding of Denom{denom, description, ticker, precision,
+	// max_supply, supply, can_change_max_supply, owner}, with int64 supplies.
+	legacyDenom := func(denom, ticker string) []byte {
+		var bz []byte
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendString(bz, denom)
+		bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+		bz = protowire.AppendString(bz, "Token")
+		bz = protowire.AppendTag(bz, 3, protowire.BytesType)
+		bz = protowire.AppendString(bz, ticker)
+		bz = protowire.AppendTag(bz, 4, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, 6)
+		bz = protowire.AppendTag(bz, 6, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, 1000)
+		bz = protowire.AppendTag(bz, 7, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, 250)
+		bz = protowire.AppendTag(bz, 8, protowire.VarintType)
+		bz = protowire.AppendVarint(bz, 1)
+		bz = protowire.AppendTag(bz, 9, protowire.BytesType)
+		bz = protowire.AppendString(bz, owner)
+		return bz
+	}
+	sdkCtx.KVStore(f.storeKey).Set(append(types.DenomKey.Bytes(), []byte(denom)...), legacyDenom(denom, "OLD"))
+	sdkCtx.KVStore(f.storeKey).Set(append(types.DenomKey.Bytes(), []byte(invalid)...), legacyDenom(invalid, "X"))
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{}))
+
+	m := keeper.NewMigrator(f.keeper)
+	require.NoError(t, m.Migrate1to2(sdkCtx))
+
+	got, err := f.keeper.Denom.Get(f.ctx, denom)
+	require.NoError(t, err)
+	require.Equal(t, "OLD", got.Ticker)
+	require.Equal(t, int64(6), got.Precision)
+	require.Equal(t, math.NewInt(1000), got.MaxSupply)
+	require.Equal(t, math.NewInt(250), got.Supply)
+	require.True(t, got.CanChangeMaxSupply)
+	require.Equal(t, owner, got.Owner)
+
+	// Denoms are indexed by owner
+	iter, err := f.keeper.Denom.Indexes.Owner.MatchExact(f.ctx, owner)
+	require.NoError(t, err)
+	denoms, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{denom, invalid}, denoms)
+
+	// Owners hold every role
+	grant, err := f.keeper.RoleGrant.Get(f.ctx, collections.Join(denom, owner))
+	require.NoError(t, err)
+	require.ElementsMatch(t, types.AllRoles, grant.Roles)
+	require.Nil(t, grant.MintAllowance)
+
+	// Bank metadata states that clawback is disabled, and denoms that cannot
+	// form valid metadata are skipped
+	metadata, found := f.bankKeeper.metadata[denom]
+	require.True(t, found)
+	require.Equal(t, "old", metadata.Display)
+	require.Equal(t, "Token ("+types.ClawbackDisabledNotice+")", metadata.Description)
+	_, found = f.bankKeeper.metadata[invalid]
+	require.False(t, found)
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// Only the escrows of ICS-20 channels are recorded
+	has, err := f.keeper.EscrowAddress.Has(f.ctx, ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0"))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.EscrowAddress.Has(f.ctx, ibctransfertypes.GetEscrowAddress("icahost", "channel-1"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
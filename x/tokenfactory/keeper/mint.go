@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// mintToModule checks that minter holds the minter role of an unpaused denom
+// and that amount fits the max supply and the mint limits of minter, then
+// mints amount to the module account and records the new supply. The caller
+// sends the returned coins on.
+func (k Keeper) mintToModule(ctx context.Context, minter, denomName string, amount math.Int) (sdk.Coins, error) {
+	denom, err := k.Denom.Get(ctx, denomName)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	grant, err := k.requireRole(ctx, denomName, minter, types.ROLE_MINTER)
+	if err != nil {
+		return nil, err
+	}
+
+	if denom.Paused {
+		return nil, errorsmod.Wrap(types.ErrDenomPaused, "cannot mint a paused denom")
+	}
+
+	newSupply, err := denom.Supply.SafeAdd(amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("supply overflow: %s", err))
+	}
+	if newSupply.GT(denom.MaxSupply) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minting would exceed max supply")
+	}
+
+	if err := k.chargeMintAllowance(ctx, grant, amount); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denomName, amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to mint coins: %s", err))
+	}
+
+	denom.Supply = newSupply
+	if err := k.Denom.Set(ctx, denomName, denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update denom supply")
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// mockAuthKeeper is an in-memory implementation of types.AuthKeeper that
+// stores accounts by address for keeper tests.
+type mockAuthKeeper struct {
+	addressCodec  address.Codec
+	accounts      map[string]sdk.AccountI
+	nextAccNumber uint64
+}
+
+func newMockAuthKeeper(addressCodec address.Codec) *mockAuthKeeper {
+	return &mockAuthKeeper{addressCodec: addressCodec, accounts: make(map[string]sdk.AccountI)}
+}
+
+func (a *mockAuthKeeper) AddressCodec() address.Codec {
+	return a.addressCodec
+}
+
+func (a *mockAuthKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return a.accounts[addr.String()]
+}
+
+func (a *mockAuthKeeper) NewAccount(_ context.Context, acc sdk.AccountI) sdk.AccountI {
+	if err := acc.SetAccountNumber(a.nextAccNumber); err != nil {
+		panic(err)
+	}
+	a.nextAccNumber++
+	return acc
+}
+
+func (a *mockAuthKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	a.accounts[acc.GetAddress().String()] = acc
+}
+
+func (a *mockAuthKeeper) GetModulePermissions() map[string]authtypes.PermissionsForAddress {
+	perms := make(map[string]authtypes.PermissionsForAddress)
+	for _, acc := range a.accounts {
+		if moduleAcc, ok := acc.(sdk.ModuleAccountI); ok {
+			perms[moduleAcc.GetName()] = authtypes.NewPermissionsForAddress(moduleAcc.GetName(), moduleAcc.GetPermissions())
+		}
+	}
+
+	return perms
+}
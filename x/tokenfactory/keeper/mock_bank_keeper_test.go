@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockBankKeeper is an in-memory implementation of types.BankKeeper that
+// tracks balances and total supply for keeper tests.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+	metadata map[string]banktypes.Metadata
+	blocked  map[string]bool
+	// restriction, when set, runs on SendCoins like a bank send restriction
+	restriction func(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error)
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		balances: make(map[string]sdk.Coins),
+		metadata: make(map[string]banktypes.Metadata),
+		blocked:  make(map[string]bool),
+	}
+}
+
+func (b *mockBankKeeper) SetDenomMetaData(_ context.Context, denomMetaData banktypes.Metadata) {
+	b.metadata[denomMetaData.Base] = denomMetaData
+}
+
+func (b *mockBankKeeper) RemoveDenomMetaData(_ context.Context, denom string) error {
+	delete(b.metadata, denom)
+	return nil
+}
+
+func (b *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.supply.AmountOf(denom))
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	b.balances[addr] = b.balances[addr].Add(amt...)
+	b.supply = b.supply.Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance, negative := b.balances[addr].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient module balance to burn %s", amt)
+	}
+	b.balances[addr] = balance
+	b.supply = b.supply.Sub(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := b.balances[from.String()].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if b.restriction != nil {
+		var err error
+		if toAddr, err = b.restriction(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+
+	return b.send(fromAddr, toAddr, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) InputOutputCoins(_ context.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	from, err := sdk.AccAddressFromBech32(input.Address)
+	if err != nil {
+		return err
+	}
+
+	for _, output := range outputs {
+		to, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		if err := b.send(from, to, output.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return b.blocked[addr.String()]
+}
+
+func (b *mockBankKeeper) DenomOwners(_ context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
+	addrs := make([]string, 0, len(b.balances))
+	for addr, coins := range b.balances {
+		if coins.AmountOf(req.Denom).IsPositive() {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+
+	owners := make([]*banktypes.DenomOwner, 0, len(addrs))
+	for _, addr := range addrs {
+		owners = append(owners, &banktypes.DenomOwner{Address: addr, Balance: sdk.NewCoin(req.Denom, b.balances[addr].AmountOf(req.Denom))})
+	}
+
+	return &banktypes.QueryDenomOwnersResponse{DenomOwners: owners, Pagination: &query.PageResponse{Total: uint64(len(owners))}}, nil
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// mockChannelKeeper implements types.ChannelKeeper over a fixed list of
+// channels.
+type mockChannelKeeper struct {
+	channels []channeltypes.IdentifiedChannel
+}
+
+func newMockChannelKeeper() *mockChannelKeeper {
+	return &mockChannelKeeper{}
+}
+
+func (c *mockChannelKeeper) GetAllChannelsWithPortPrefix(_ sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range c.channels {
+		if strings.HasPrefix(channel.PortId, portPrefix) {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels
+}
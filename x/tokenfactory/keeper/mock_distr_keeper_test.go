@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// mockDistrKeeper implements types.DistrKeeper on top of mockBankKeeper and
+// tracks the community pool for keeper tests.
+type mockDistrKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+func newMockDistrKeeper(bankKeeper *mockBankKeeper) *mockDistrKeeper {
+	return &mockDistrKeeper{bankKeeper: bankKeeper}
+}
+
+func (d *mockDistrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := d.bankKeeper.send(sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount); err != nil {
+		return err
+	}
+	d.communityPool = d.communityPool.Add(amount...)
+	return nil
+}
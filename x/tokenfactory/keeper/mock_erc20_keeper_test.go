@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// mockErc20Keeper implements types.Erc20Keeper and records the registered
+// token pairs and dynamic precompiles for keeper tests. It also implements
+// types.EVMKeeper over the addresses that hold contract code.
+type mockErc20Keeper struct {
+	disabled    bool
+	pairs       map[string]erc20types.TokenPair
+	precompiles []common.Address
+	contracts   map[common.Address]bool
+}
+
+func newMockErc20Keeper() *mockErc20Keeper {
+	return &mockErc20Keeper{
+		pairs:     make(map[string]erc20types.TokenPair),
+		contracts: make(map[common.Address]bool),
+	}
+}
+
+func (e *mockErc20Keeper) IsERC20Enabled(_ sdk.Context) bool {
+	return !e.disabled
+}
+
+func (e *mockErc20Keeper) IsDenomRegistered(_ sdk.Context, denom string) bool {
+	_, found := e.pairs[denom]
+	return found
+}
+
+func (e *mockErc20Keeper) SetToken(_ sdk.Context, pair erc20types.TokenPair) error {
+	e.pairs[pair.Denom] = pair
+	return nil
+}
+
+func (e *mockErc20Keeper) EnableDynamicPrecompile(_ sdk.Context, address common.Address) error {
+	e.precompiles = append(e.precompiles, address)
+	e.contracts[address] = true
+	return nil
+}
+
+func (e *mockErc20Keeper) GetTokenPairID(_ sdk.Context, token string) []byte {
+	if _, found := e.pairs[token]; !found {
+		return nil
+	}
+	return []byte(token)
+}
+
+func (e *mockErc20Keeper) GetTokenPair(_ sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	pair, found := e.pairs[string(id)]
+	return pair, found
+}
+
+func (e *mockErc20Keeper) DeleteTokenPair(_ sdk.Context, pair erc20types.TokenPair) {
+	delete(e.pairs, pair.Denom)
+}
+
+func (e *mockErc20Keeper) DeleteDynamicPrecompile(_ sdk.Context, address common.Address) {
+	e.precompiles = slices.DeleteFunc(e.precompiles, func(precompile common.Address) bool {
+		return precompile == address
+	})
+}
+
+func (e *mockErc20Keeper) UnRegisterERC20CodeHash(_ sdk.Context, address common.Address) error {
+	delete(e.contracts, address)
+	return nil
+}
+
+func (e *mockErc20Keeper) GetAccount(_ sdk.Context, address common.Address) *statedb.Account {
+	if !e.contracts[address] {
+		return nil
+	}
+	return &statedb.Account{CodeHash: crypto.Keccak256([]byte("code"))}
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+)
+
+// mockTransferModule stands in for the ICS-20 transfer stack below the
+// tokenfactory IBC middleware. Only the channel handshake is implemented.
+type mockTransferModule struct {
+	porttypes.IBCModule
+}
+
+func (mockTransferModule) OnChanOpenInit(_ sdk.Context, _ channeltypes.Order, _ []string, _, _ string, _ channeltypes.Counterparty, version string) (string, error) {
+	return version, nil
+}
+
+// mockTransferModuleV2 stands in for the IBC v2 ICS-20 transfer stack. Sent
+// packets are handed to onSend, which escrows the tokens.
+type mockTransferModuleV2 struct {
+	ibcapi.IBCModule
+	onSend func(ctx sdk.Context, sourceClient string, payload channeltypesv2.Payload, signer sdk.AccAddress) error
+}
+
+func (m mockTransferModuleV2) OnSendPacket(ctx sdk.Context, sourceClient, _ string, _ uint64, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+	return m.onSend(ctx, sourceClient, payload, signer)
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockWasmKeeper implements types.WasmKeeper. It knows the code ID of each
+// contract, records the sudo messages it receives and rejects them while
+// reject is set. Each sudo call consumes gasPerCall and runs onSudo, when
+// set, with the context of the call.
+type mockWasmKeeper struct {
+	codeIDs    map[string]uint64
+	sudoMsgs   [][]byte
+	reject     bool
+	gasPerCall uint64
+	onSudo     func(ctx context.Context)
+}
+
+func newMockWasmKeeper() *mockWasmKeeper {
+	return &mockWasmKeeper{codeIDs: make(map[string]uint64)}
+}
+
+func (w *mockWasmKeeper) GetContractInfo(_ context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	codeID, found := w.codeIDs[string(contractAddress)]
+	if !found {
+		return nil
+	}
+
+	return &wasmtypes.ContractInfo{CodeID: codeID}
+}
+
+func (w *mockWasmKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(w.gasPerCall, "mock sudo")
+	w.sudoMsgs = append(w.sudoMsgs, msg)
+	if w.onSudo != nil {
+		w.onSudo(ctx)
+	}
+	if w.reject {
+		return nil, errors.New("transfer rejected")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) ApproveBurn(ctx context.Context, msg *types.MsgApproveBurn) (*types.MsgApproveBurnResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Holder); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid holder address: %s", err))
+	}
+
+	found, err := k.Denom.Has(ctx, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+	}
+
+	allowanceKey := collections.Join(msg.Denom, msg.Holder)
+	event := &types.EventBurnApproved{Denom: msg.Denom, Holder: msg.Holder, Amount: msg.Amount}
+
+	// A zero amount revokes any existing allowance
+	if msg.Amount.IsZero() {
+		if err := k.BurnAllowance.Remove(ctx, allowanceKey); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to revoke burn allowance")
+		}
+		if err := k.eventService.EventManager(ctx).Emit(ctx, event); err != nil {
+			return nil, err
+		}
+		return &types.MsgApproveBurnResponse{}, nil
+	}
+
+	allowance := types.BurnAllowance{
+		Denom:  msg.Denom,
+		Holder: msg.Holder,
+		Amount: msg.Amount,
+	}
+	if err := k.BurnAllowance.Set(ctx, allowanceKey, allowance); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set burn allowance")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveBurnResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) SetBeforeSendHook(ctx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, msg.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.Creator != denom.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can set the before send hook")
+	}
+
+	if msg.ContractAddress == "" {
+		if err := k.BeforeSendHook.Remove(ctx, msg.Denom); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove before send hook")
+		}
+	} else {
+		if err := k.validateHookContract(ctx, msg.ContractAddress); err != nil {
+			return nil, err
+		}
+		if err := k.BeforeSendHook.Set(ctx, msg.Denom, msg.ContractAddress); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set before send hook")
+		}
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventBeforeSendHookSet{
+		Denom:           msg.Denom,
+		ContractAddress: msg.ContractAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+// validateHookContract checks that contract exists and was instantiated from
+// a code ID the params allow as a before-send hook.
+func (k msgServer) validateHookContract(ctx context.Context, contract string) error {
+	if k.wasmKeeper == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "before send hooks require CosmWasm")
+	}
+
+	contractAddr, err := k.addressCodec.StringToBytes(contract)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid contract address: %s", err))
+	}
+
+	info := k.wasmKeeper.GetContractInfo(ctx, contractAddr)
+	if info == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "contract %s not found", contract)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if !params.IsHookCodeIDAllowed(info.CodeID) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "code id %d is not allowed as a before send hook", info.CodeID)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestBeforeSendHookMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	holderAddr := sdk.AccAddress("holderAddr__________________")
+	hookAddr := sdk.AccAddress("hookContract________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString(holderAddr)
+	require.NoError(t, err)
+	hook, err := f.addressCodec.BytesToString(hookAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: holder, Denom: token, ContractAddress: hook})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: "unknown", ContractAddress: hook})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// The contract must exist and its code ID must be allowed
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: token, ContractAddress: hook})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	f.wasmKeeper.codeIDs[string(hookAddr)] = 7
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: token, ContractAddress: hook})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	params := types.DefaultParams()
+	params.AllowedHookCodeIds = []uint64{7}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: token, ContractAddress: hook})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventBeforeSendHookSet{Denom: token, ContractAddress: hook})
+
+	res, err := qs.BeforeSendHook(f.ctx, &types.QueryBeforeSendHookRequest{Denom: token})
+	require.NoError(t, err)
+	require.Equal(t, hook, res.ContractAddress)
+
+	// The hook sees every transfer of the denom and can reject it
+	coins := sdk.NewCoins(sdk.NewInt64Coin(token, 5))
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, coins)
+	require.NoError(t, err)
+	require.Len(t, f.wasmKeeper.sudoMsgs, 1)
+
+	var sudoMsg types.BlockBeforeSendSudoMsg
+	require.NoError(t, json.Unmarshal(f.wasmKeeper.sudoMsgs[0], &sudoMsg))
+	require.Equal(t, types.BlockBeforeSend{From: owner, To: holder, Amount: coins[0]}, sudoMsg.BlockBeforeSend)
+
+	// Writes of a rejecting hook are discarded
+	f.wasmKeeper.reject = true
+	f.wasmKeeper.onSudo = func(ctx context.Context) {
+		require.NoError(t, f.keeper.FrozenAccount.Set(ctx, collections.Join(token, holder)))
+	}
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, coins)
+	require.ErrorIs(t, err, types.ErrBeforeSendHook)
+	frozen, err := f.keeper.FrozenAccount.Has(f.ctx, collections.Join(token, holder))
+	require.NoError(t, err)
+	require.False(t, frozen)
+	f.wasmKeeper.onSudo = nil
+
+	// Other denoms never reach the hook
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.NoError(t, err)
+	require.Len(t, f.wasmKeeper.sudoMsgs, 2)
+
+	// A hook migrated to a code ID that is not allowed is not called and
+	// rejects the transfer
+	f.wasmKeeper.reject = false
+	f.wasmKeeper.codeIDs[string(hookAddr)] = 8
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, coins)
+	require.ErrorIs(t, err, types.ErrBeforeSendHook)
+	require.Len(t, f.wasmKeeper.sudoMsgs, 2)
+	f.wasmKeeper.codeIDs[string(hookAddr)] = 7
+
+	// Removing the hook lets transfers through again
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventBeforeSendHookSet{Denom: token})
+
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, coins)
+	require.NoError(t, err)
+	require.Len(t, f.wasmKeeper.sudoMsgs, 2)
+
+	res, err = qs.BeforeSendHook(f.ctx, &types.QueryBeforeSendHookRequest{Denom: token})
+	require.NoError(t, err)
+	require.Empty(t, res.ContractAddress)
+}
+
+func TestBeforeSendHookGasLimit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	holderAddr := sdk.AccAddress("holderAddr__________________")
+	hookAddr := sdk.AccAddress("hookContract________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	hook, err := f.addressCodec.BytesToString(hookAddr)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.AllowedHookCodeIds = []uint64{1}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.wasmKeeper.codeIDs[string(hookAddr)] = 1
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: resp.NewTokenDenom, ContractAddress: hook})
+	require.NoError(t, err)
+
+	// A hook that exceeds the cap fails the transfer, and the cap is charged
+	// on top of the store reads of the restriction
+	f.wasmKeeper.gasPerCall = types.BeforeSendHookGasLimit + 1
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	before := sdkCtx.GasMeter().GasConsumed()
+
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(resp.NewTokenDenom, 1)))
+	require.ErrorIs(t, err, types.ErrBeforeSendHook)
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed()-before, types.BeforeSendHookGasLimit)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if err := k.burnTokens(ctx, msg.Denom, creatorAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventBurn{
+		Denom:  msg.Denom,
+		Burner: msg.Creator,
+		Holder: msg.Creator,
+		Amount: msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// burnTokens moves amount tokens of denom from holder to the module account,
+// burns them and lowers the tracked supply accordingly.
+func (k Keeper) burnTokens(ctx context.Context, denomName string, holder sdk.AccAddress, amount math.Int) error {
+	denom, err := k.Denom.Get(ctx, denomName)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if amount.GT(denom.Supply) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "burn amount exceeds current supply")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denomName, amount))
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, fmt.Sprintf("failed to collect coins: %s", err))
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to burn coins: %s", err))
+	}
+
+	denom.Supply = denom.Supply.Sub(amount)
+	if err := k.Denom.Set(ctx, denomName, denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update denom supply")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) BurnFrom(ctx context.Context, msg *types.MsgBurnFrom) (*types.MsgBurnFromResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	holderAddr, err := k.addressCodec.StringToBytes(msg.Holder)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid holder address: %s", err))
+	}
+
+	if _, err := k.Denom.Get(ctx, msg.Denom); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// The creator must hold the burner role
+	if _, err := k.requireRole(ctx, msg.Denom, msg.Creator, types.ROLE_BURNER); err != nil {
+		return nil, err
+	}
+
+	// Check and charge the allowance granted by the holder
+	allowanceKey := collections.Join(msg.Denom, msg.Holder)
+	allowance, err := k.BurnAllowance.Get(ctx, allowanceKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "holder has not approved burning")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.Amount.GT(allowance.Amount) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "burn amount exceeds allowance")
+	}
+
+	allowance.Amount = allowance.Amount.Sub(msg.Amount)
+	if allowance.Amount.IsZero() {
+		err = k.BurnAllowance.Remove(ctx, allowanceKey)
+	} else {
+		err = k.BurnAllowance.Set(ctx, allowanceKey, allowance)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update burn allowance")
+	}
+
+	if err := k.burnTokens(ctx, msg.Denom, holderAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventBurn{
+		Denom:  msg.Denom,
+		Burner: msg.Creator,
+		Holder: msg.Holder,
+		Amount: msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnFromResponse{}, nil
+}
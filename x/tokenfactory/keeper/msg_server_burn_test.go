@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestBurnMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(100), Recipient: holder})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgBurn
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgBurn{Creator: "invalid", Denom: token, Amount: math.NewInt(1)},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgBurn{Creator: holder, Denom: "unknown", Amount: math.NewInt(1)},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "insufficient funds",
+			request: &types.MsgBurn{Creator: owner, Denom: token, Amount: math.NewInt(1)},
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgBurn{Creator: holder, Denom: token, Amount: math.NewInt(40)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.Burn(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), denom.Supply)
+	require.Equal(t, int64(60), f.bankKeeper.supply.AmountOf(token).Int64())
+
+	// burning frees headroom under the max supply
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(40), Recipient: holder})
+	require.NoError(t, err)
+}
+
+func TestBurnFromMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(50), Recipient: holder})
+	require.NoError(t, err)
+
+	// no approval yet
+	_, err = srv.BurnFrom(f.ctx, &types.MsgBurnFrom{Creator: owner, Denom: token, Amount: math.NewInt(10), Holder: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ApproveBurn(f.ctx, &types.MsgApproveBurn{Holder: holder, Denom: token, Amount: math.NewInt(30)})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventBurnApproved{Denom: token, Holder: holder, Amount: math.NewInt(30)})
+
+	// only the owner may burn from the holder
+	_, err = srv.BurnFrom(f.ctx, &types.MsgBurnFrom{Creator: holder, Denom: token, Amount: math.NewInt(10), Holder: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// cannot exceed the allowance
+	_, err = srv.BurnFrom(f.ctx, &types.MsgBurnFrom{Creator: owner, Denom: token, Amount: math.NewInt(31), Holder: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.BurnFrom(f.ctx, &types.MsgBurnFrom{Creator: owner, Denom: token, Amount: math.NewInt(30), Holder: holder})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventBurn{Denom: token, Burner: owner, Holder: holder, Amount: math.NewInt(30)})
+
+	// the allowance is used up
+	_, err = srv.BurnFrom(f.ctx, &types.MsgBurnFrom{Creator: owner, Denom: token, Amount: math.NewInt(1), Holder: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(20), denom.Supply)
+
+	// burning the rest makes the denom deletable
+	_, err = srv.Burn(f.ctx, &types.MsgBurn{Creator: holder, Denom: token, Amount: math.NewInt(20)})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventBurn{Denom: token, Burner: holder, Holder: holder, Amount: math.NewInt(20)})
+	_, err = srv.DeleteDenom(f.ctx, &types.MsgDeleteDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventDenomDeleted{Denom: token, Owner: owner})
+}
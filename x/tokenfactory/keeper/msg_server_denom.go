@@ -7,39 +7,87 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"nimo-chain/x/tokenfactory/types"
 )
 
 func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
-	if _, err := k.addressCodec.StringToBytes(msg.Owner); err != nil {
+	ownerAddr, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
 
+	// Namespace the denom under its creator
+	newDenom, err := types.GetTokenDenom(msg.Owner, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the value already exists
-	_, err := k.Denom.Get(ctx, msg.Denom)
+	_, err = k.Denom.Get(ctx, newDenom)
 	if err == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
 
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := params.ValidateDenomFields(msg.Description, msg.Url, msg.Precision); err != nil {
+		return nil, err
+	}
+
+	if err := k.chargeDenomCreationFee(ctx, params, ownerAddr); err != nil {
+		return nil, err
+	}
+
 	var denom = types.Denom{
 		Owner:              msg.Owner,
-		Denom:              msg.Denom,
+		Denom:              newDenom,
 		Description:        msg.Description,
 		Ticker:             msg.Ticker,
 		Precision:          msg.Precision,
 		Url:                msg.Url,
 		MaxSupply:          msg.MaxSupply,
-		Supply:             0, // Initial supply is 0
+		Supply:             math.ZeroInt(), // Initial supply is 0
 		CanChangeMaxSupply: msg.CanChangeMaxSupply,
+		ClawbackEnabled:    msg.ClawbackEnabled,
 	}
 
-	if err := k.Denom.Set(ctx, msg.Denom, denom); err != nil {
+	if err := k.Denom.Set(ctx, newDenom, denom); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set denom")
 	}
 
-	return &types.MsgCreateDenomResponse{}, nil
+	if err := k.setDenomMetadata(ctx, denom); err != nil {
+		return nil, err
+	}
+
+	// The creator starts out with every role
+	if err := k.grantAllRoles(ctx, newDenom, msg.Owner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to grant roles")
+	}
+
+	erc20Address, err := k.registerERC20(ctx, params, newDenom, msg.DisableErc20)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDenomCreated{
+		Denom:              newDenom,
+		Owner:              msg.Owner,
+		Ticker:             msg.Ticker,
+		Precision:          msg.Precision,
+		MaxSupply:          msg.MaxSupply,
+		CanChangeMaxSupply: msg.CanChangeMaxSupply,
+		Erc20Address:       erc20Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: newDenom, Erc20Address: erc20Address}, nil
 }
 
 func (k msgServer) UpdateDenom(ctx context.Context, msg *types.MsgUpdateDenom) (*types.MsgUpdateDenomResponse, error) {
@@ -57,27 +105,65 @@ func (k msgServer) UpdateDenom(ctx context.Context, msg *types.MsgUpdateDenom) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
-	// Checks if the msg owner is the same as the current owner
-	if msg.Owner != val.Owner {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	// The signer must hold the metadata admin role
+	if _, err := k.requireRole(ctx, msg.Denom, msg.Owner, types.ROLE_METADATA_ADMIN); err != nil {
+		return nil, err
 	}
 
-	var denom = types.Denom{
-		Owner:              msg.Owner,
-		Denom:              msg.Denom,
-		Description:        msg.Description,
-		Ticker:             val.Ticker,    // Keep original ticker
-		Precision:          val.Precision, // Keep original precision
-		Url:                msg.Url,
-		MaxSupply:          msg.MaxSupply,
-		Supply:             val.Supply, // Keep current supply
-		CanChangeMaxSupply: msg.CanChangeMaxSupply,
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// The precision cannot change, so only the new description and url are checked
+	if err := params.ValidateDenomFields(msg.Description, msg.Url, 0); err != nil {
+		return nil, err
+	}
+
+	// Ticker, precision, supply and any state flags are kept as they are
+	denom := val
+	denom.Description = msg.Description
+	denom.Url = msg.Url
+
+	// An unset max supply leaves the supply settings as they are
+	if !msg.MaxSupply.IsNil() {
+		// A locked max supply stays locked and cannot be unlocked again
+		if !val.CanChangeMaxSupply {
+			if msg.CanChangeMaxSupply {
+				return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "max supply is locked and cannot be unlocked")
+			}
+			if !msg.MaxSupply.Equal(val.MaxSupply) {
+				return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "max supply is locked")
+			}
+		}
+
+		if msg.MaxSupply.LT(val.Supply) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max supply cannot be below the current supply")
+		}
+
+		denom.MaxSupply = msg.MaxSupply
+		denom.CanChangeMaxSupply = msg.CanChangeMaxSupply
 	}
 
 	if err := k.Denom.Set(ctx, msg.Denom, denom); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update denom")
 	}
 
+	if err := k.setDenomMetadata(ctx, denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDenomUpdated{
+		Denom:              msg.Denom,
+		Updater:            msg.Owner,
+		Description:        denom.Description,
+		Url:                denom.Url,
+		MaxSupply:          denom.MaxSupply,
+		CanChangeMaxSupply: denom.CanChangeMaxSupply,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateDenomResponse{}, nil
 }
 
@@ -101,7 +187,7 @@ func (k msgServer) DeleteDenom(ctx context.Context, msg *types.MsgDeleteDenom) (
 	}
 
 	// Check if there are any tokens in circulation
-	if denom.Supply > 0 {
+	if denom.Supply.IsPositive() {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot delete denom with tokens in circulation")
 	}
 
@@ -110,5 +196,45 @@ func (k msgServer) DeleteDenom(ctx context.Context, msg *types.MsgDeleteDenom) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete denom")
 	}
 
+	// Drop any burn allowances left for the denom
+	if err := k.BurnAllowance.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear burn allowances")
+	}
+
+	// Drop the frozen accounts of the denom
+	if err := k.FrozenAccount.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear frozen accounts")
+	}
+
+	// Drop the role grants of the denom
+	if err := k.RoleGrant.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear role grants")
+	}
+	if err := k.EpochMint.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear epoch mints")
+	}
+	if err := k.removeOwnershipProposal(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove ownership proposal")
+	}
+	if err := k.BeforeSendHook.Remove(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove before send hook")
+	}
+	if err := k.SupplyCheckpoint.Clear(ctx, collections.NewPrefixedPairRange[string, int64](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear supply checkpoints")
+	}
+	if err := k.removeFeeDenom(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove fee denom")
+	}
+	if err := k.removeDenomMetadata(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove bank metadata")
+	}
+	if err := k.removeERC20(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove ERC-20 token pair")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDenomDeleted{Denom: msg.Denom, Owner: denom.Owner}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDeleteDenomResponse{}, nil
 }
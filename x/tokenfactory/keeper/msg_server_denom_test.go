@@ -4,7 +4,11 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"nimo-chain/x/tokenfactory/keeper"
@@ -19,13 +23,30 @@ func TestDenomMsgServerCreate(t *testing.T) {
 
 	for i := 0; i < 5; i++ {
 		expected := &types.MsgCreateDenom{Owner: owner,
-			Denom: strconv.Itoa(i),
+			Subdenom: "denom" + strconv.Itoa(i),
 		}
-		_, err := srv.CreateDenom(f.ctx, expected)
+		resp, err := srv.CreateDenom(f.ctx, expected)
 		require.NoError(t, err)
-		rst, err := f.keeper.Denom.Get(f.ctx, expected.Denom)
+		require.Equal(t, "factory/"+owner+"/denom"+strconv.Itoa(i), resp.NewTokenDenom)
+		rst, err := f.keeper.Denom.Get(f.ctx, resp.NewTokenDenom)
 		require.NoError(t, err)
 		require.Equal(t, expected.Owner, rst.Owner)
+		requireEvent(t, f.ctx, &types.EventDenomCreated{Denom: resp.NewTokenDenom, Owner: owner, MaxSupply: math.ZeroInt(), Erc20Address: resp.Erc20Address})
+	}
+
+	// the same subdenom cannot be created twice by one owner
+	_, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "denom0"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// but another creator gets its own namespace
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	_, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: other, Subdenom: "denom0"})
+	require.NoError(t, err)
+
+	for _, subdenom := range []string{"", sdk.DefaultBondDenom, "ibc", "factory", "1abc", "a/b"} {
+		_, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: subdenom})
+		require.ErrorIs(t, err, types.ErrInvalidDenom, subdenom)
 	}
 }
 
@@ -40,10 +61,12 @@ func TestDenomMsgServerUpdate(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := &types.MsgCreateDenom{Owner: owner,
-		Denom: strconv.Itoa(0),
+		Subdenom:  "denom0",
+		MaxSupply: math.NewInt(100),
 	}
-	_, err = srv.CreateDenom(f.ctx, expected)
+	resp, err := srv.CreateDenom(f.ctx, expected)
 	require.NoError(t, err)
+	denom := resp.NewTokenDenom
 
 	tests := []struct {
 		desc    string
@@ -53,28 +76,52 @@ func TestDenomMsgServerUpdate(t *testing.T) {
 		{
 			desc: "invalid address",
 			request: &types.MsgUpdateDenom{Owner: "invalid",
-				Denom: strconv.Itoa(0),
+				Denom: denom,
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
 		{
 			desc: "unauthorized",
 			request: &types.MsgUpdateDenom{Owner: unauthorizedAddr,
-				Denom: strconv.Itoa(0),
+				Denom: denom,
 			},
 			err: sdkerrors.ErrUnauthorized,
 		},
 		{
 			desc: "key not found",
 			request: &types.MsgUpdateDenom{Owner: owner,
-				Denom: strconv.Itoa(100000),
+				Denom: "factory/" + owner + "/missing",
 			},
 			err: sdkerrors.ErrKeyNotFound,
 		},
+		{
+			desc: "locked max supply",
+			request: &types.MsgUpdateDenom{Owner: owner,
+				Denom:     denom,
+				MaxSupply: math.NewInt(200),
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "unlock max supply",
+			request: &types.MsgUpdateDenom{Owner: owner,
+				Denom:              denom,
+				MaxSupply:          math.NewInt(100),
+				CanChangeMaxSupply: true,
+			},
+			err: sdkerrors.ErrUnauthorized,
+		},
 		{
 			desc: "completed",
 			request: &types.MsgUpdateDenom{Owner: owner,
-				Denom: strconv.Itoa(0),
+				Denom:     denom,
+				MaxSupply: math.NewInt(100),
+			},
+		},
+		{
+			desc: "unset max supply is kept",
+			request: &types.MsgUpdateDenom{Owner: owner,
+				Denom: denom,
 			},
 		},
 	}
@@ -85,14 +132,50 @@ func TestDenomMsgServerUpdate(t *testing.T) {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
-				rst, err := f.keeper.Denom.Get(f.ctx, expected.Denom)
+				rst, err := f.keeper.Denom.Get(f.ctx, denom)
 				require.NoError(t, err)
 				require.Equal(t, expected.Owner, rst.Owner)
+				requireEvent(t, f.ctx, &types.EventDenomUpdated{Denom: denom, Updater: owner, MaxSupply: math.NewInt(100)})
 			}
 		})
 	}
 }
 
+func TestDenomMsgServerUpdateMaxSupply(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100), CanChangeMaxSupply: true})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(60), Recipient: owner})
+	require.NoError(t, err)
+
+	update := func(maxSupply int64, canChange bool) error {
+		_, err := srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: math.NewInt(maxSupply), CanChangeMaxSupply: canChange})
+		return err
+	}
+
+	// The cap can move freely while unlocked, but never below the supply
+	require.ErrorIs(t, update(59, true), sdkerrors.ErrInvalidRequest)
+	require.NoError(t, update(60, true))
+	require.NoError(t, update(500, true))
+
+	// Locking keeps the current cap for good
+	require.NoError(t, update(300, false))
+	require.ErrorIs(t, update(300, true), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, update(400, false), sdkerrors.ErrUnauthorized)
+	require.NoError(t, update(300, false))
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), denom.MaxSupply)
+	require.False(t, denom.CanChangeMaxSupply)
+}
+
 // func TestDenomMsgServerDelete(t *testing.T) {
 // 	f := initFixture(t)
 // 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -155,3 +238,187 @@ func TestDenomMsgServerUpdate(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestDenomMsgServerMetadata(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{
+		Owner:       owner,
+		Subdenom:    "nimo",
+		Description: "Nimo token",
+		Ticker:      "NIMO",
+		Precision:   6,
+		Url:         "https://nimo.example",
+		MaxSupply:   math.NewInt(1000),
+	})
+	require.NoError(t, err)
+
+	metadata, found := f.bankKeeper.metadata[resp.NewTokenDenom]
+	require.True(t, found)
+	require.Equal(t, resp.NewTokenDenom, metadata.Base)
+	require.Equal(t, "nimo", metadata.Display)
+	require.Equal(t, "NIMO", metadata.Symbol)
+	// Denoms without clawback say so in their metadata
+	require.Equal(t, "Nimo token ("+types.ClawbackDisabledNotice+")", metadata.Description)
+	require.Equal(t, "https://nimo.example", metadata.URI)
+	require.Len(t, metadata.DenomUnits, 2)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+
+	_, err = srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{
+		Owner:       owner,
+		Denom:       resp.NewTokenDenom,
+		Description: "Updated",
+		Url:         "https://updated.example",
+		MaxSupply:   math.NewInt(1000),
+	})
+	require.NoError(t, err)
+
+	metadata = f.bankKeeper.metadata[resp.NewTokenDenom]
+	require.Equal(t, "Updated ("+types.ClawbackDisabledNotice+")", metadata.Description)
+	require.Equal(t, "https://updated.example", metadata.URI)
+	require.Equal(t, "nimo", metadata.Display)
+
+	// a ticker that cannot name a display unit is rejected
+	_, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "short", Ticker: "X", Precision: 6})
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	// deleting the denom removes its metadata
+	_, err = srv.DeleteDenom(f.ctx, &types.MsgDeleteDenom{Creator: owner, Denom: resp.NewTokenDenom})
+	require.NoError(t, err)
+	_, found = f.bankKeeper.metadata[resp.NewTokenDenom]
+	require.False(t, found)
+}
+
+func TestDenomMsgServerCreationParams(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	params := types.DefaultParams()
+	params.DenomCreationFee = fee
+	params.DenomCreationGasConsume = 50_000
+	params.MaxDescriptionLength = 8
+	params.MaxUrlLength = 8
+	params.MaxPrecision = 6
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	create := func(subdenom, description, url string, precision int64) error {
+		_, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{
+			Owner:       owner,
+			Subdenom:    subdenom,
+			Description: description,
+			Url:         url,
+			Precision:   precision,
+			MaxSupply:   math.NewInt(100),
+		})
+		return err
+	}
+
+	require.ErrorIs(t, create("long", "too long description", "", 0), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, create("long", "", "https://too.long", 0), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, create("precise", "", "", 7), sdkerrors.ErrInvalidRequest)
+
+	// The owner cannot pay the fee yet
+	require.ErrorIs(t, create("token", "", "", 6), sdkerrors.ErrInsufficientFunds)
+
+	f.bankKeeper.balances[ownerAddr.String()] = fee.MulInt(math.NewInt(2))
+	f.bankKeeper.supply = fee.MulInt(math.NewInt(2))
+
+	// The fee goes to the community pool and the extra gas is charged
+	gasBefore := sdk.UnwrapSDKContext(f.ctx).GasMeter().GasConsumed()
+	require.NoError(t, create("token", "short", "", 6))
+	require.GreaterOrEqual(t, sdk.UnwrapSDKContext(f.ctx).GasMeter().GasConsumed()-gasBefore, params.DenomCreationGasConsume)
+	require.Equal(t, fee, f.distrKeeper.communityPool)
+	require.Equal(t, fee, f.bankKeeper.balances[ownerAddr.String()])
+
+	// Or it is burned
+	params.BurnDenomCreationFee = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, create("burned", "", "", 0))
+	require.Equal(t, fee, f.distrKeeper.communityPool)
+	require.True(t, f.bankKeeper.balances[ownerAddr.String()].IsZero())
+	require.Equal(t, fee, f.bankKeeper.supply)
+
+	// The limits also apply when updating a denom
+	_, err = srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{
+		Owner:       owner,
+		Denom:       "factory/" + owner + "/token",
+		Description: "too long description",
+		MaxSupply:   math.NewInt(100),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestDenomMsgServerErc20Registration(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", Ticker: "TKN", Precision: 6, MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	address := types.ERC20Address(resp.NewTokenDenom)
+	require.Equal(t, address.Hex(), resp.Erc20Address)
+	requireEvent(t, f.ctx, &types.EventDenomCreated{Denom: resp.NewTokenDenom, Owner: owner, Ticker: "TKN", Precision: 6, MaxSupply: math.NewInt(100), Erc20Address: address.Hex()})
+
+	pair, found := f.erc20Keeper.pairs[resp.NewTokenDenom]
+	require.True(t, found)
+	require.Equal(t, address.Hex(), pair.Erc20Address)
+	require.Equal(t, erc20types.OWNER_MODULE, pair.ContractOwner)
+	require.True(t, pair.Enabled)
+	require.Equal(t, []common.Address{address}, f.erc20Keeper.precompiles)
+
+	// The ERC-20 precompile takes name, symbol and decimals from the metadata
+	metadata := f.bankKeeper.metadata[resp.NewTokenDenom]
+	require.Equal(t, "TKN", metadata.Symbol)
+
+	// A denom can opt out
+	resp, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "optout", MaxSupply: math.NewInt(100), DisableErc20: true})
+	require.NoError(t, err)
+	require.Empty(t, resp.Erc20Address)
+	require.NotContains(t, f.erc20Keeper.pairs, resp.NewTokenDenom)
+
+	// So can the whole chain
+	params := types.DefaultParams()
+	params.EnableErc20Registration = false
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	resp, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "off", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	require.Empty(t, resp.Erc20Address)
+
+	// Nothing is registered while the ERC-20 module is disabled
+	params.EnableErc20Registration = true
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.erc20Keeper.disabled = true
+	resp, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "disabled", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	require.Empty(t, resp.Erc20Address)
+	require.Len(t, f.erc20Keeper.pairs, 1)
+
+	// Deleting the denom drops its pair and precompile, so it gets them back
+	// when recreated
+	token := "factory/" + owner + "/token"
+	f.erc20Keeper.disabled = false
+	_, err = srv.DeleteDenom(f.ctx, &types.MsgDeleteDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	require.Empty(t, f.erc20Keeper.pairs)
+	require.Empty(t, f.erc20Keeper.precompiles)
+
+	resp, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	require.Equal(t, address.Hex(), resp.Erc20Address)
+
+	// No pair is registered over an existing contract
+	f.erc20Keeper.contracts[types.ERC20Address("factory/"+owner+"/taken")] = true
+	_, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "taken", MaxSupply: math.NewInt(100)})
+	require.ErrorIs(t, err, erc20types.ErrTokenPairAlreadyExists)
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) DistributeToHolders(ctx context.Context, msg *types.MsgDistributeToHolders) (*types.MsgDistributeToHoldersResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if !msg.RewardCoins.IsValid() || msg.RewardCoins.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "reward coins must be valid and positive")
+	}
+
+	denom, err := k.Denom.Get(ctx, msg.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.Creator != denom.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can distribute to holders")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	var active uint64
+	if err := k.ActiveDistribution.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](msg.Denom), func(_ collections.Pair[string, uint64]) (bool, error) {
+		active++
+		return false, nil
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if active >= params.MaxActiveDistributions {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom already has %d active distributions", active)
+	}
+
+	supply, err := k.holderSupply(ctx, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !supply.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom has no holders")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, msg.RewardCoins); err != nil {
+		return nil, err
+	}
+
+	id, err := k.DistributionSeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	distribution := types.Distribution{
+		Id:        id,
+		Denom:     msg.Denom,
+		Creator:   msg.Creator,
+		Rewards:   msg.RewardCoins,
+		Remaining: msg.RewardCoins,
+		Supply:    supply,
+		Height:    sdkCtx.BlockHeight(),
+		Expiry:    sdkCtx.BlockTime().Add(time.Duration(params.DistributionClaimPeriod) * time.Second),
+	}
+	if err := k.setDistribution(ctx, distribution); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set distribution")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDistributionCreated{
+		Id:      id,
+		Denom:   msg.Denom,
+		Creator: msg.Creator,
+		Rewards: msg.RewardCoins,
+		Expiry:  distribution.Expiry,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDistributeToHoldersResponse{DistributionId: id}, nil
+}
+
+func (k msgServer) ClaimDistribution(ctx context.Context, msg *types.MsgClaimDistribution) (*types.MsgClaimDistributionResponse, error) {
+	claimer, err := k.addressCodec.StringToBytes(msg.Claimer)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid claimer address: %s", err))
+	}
+
+	distribution, err := k.Distribution.Get(ctx, msg.DistributionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "distribution not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if !sdk.UnwrapSDKContext(ctx).BlockTime().Before(distribution.Expiry) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "distribution expired")
+	}
+
+	key := collections.Join(distribution.Id, msg.Claimer)
+	claimed, err := k.DistributionClaim.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if claimed {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "distribution already claimed")
+	}
+
+	share, err := k.distributionShare(ctx, distribution, msg.Claimer)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if share.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nothing to claim")
+	}
+
+	if err := k.DistributionClaim.Set(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set distribution claim")
+	}
+	if err := k.DistributionBalance.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove distribution balance")
+	}
+
+	distribution.Remaining = distribution.Remaining.Sub(share...)
+	if err := k.Distribution.Set(ctx, distribution.Id, distribution); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set distribution")
+	}
+	// Balances no longer matter once every reward was claimed
+	if distribution.Remaining.IsZero() {
+		if err := k.ActiveDistribution.Remove(ctx, collections.Join(distribution.Denom, distribution.Id)); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to deactivate distribution")
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, share); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDistributionClaimed{
+		DistributionId: distribution.Id,
+		Claimer:        msg.Claimer,
+		Amount:         share,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDistributionResponse{Amount: share}, nil
+}
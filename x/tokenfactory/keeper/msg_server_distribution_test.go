@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestDistributionMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	f.bankKeeper.restriction = f.keeper.SendRestrictionFn
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	aliceAddr := sdk.AccAddress("aliceAddr___________________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString(sdk.AccAddress("bobAddr_____________________"))
+	require.NoError(t, err)
+	carolAddr := sdk.AccAddress("carolAddr___________________")
+	carol, err := f.addressCodec.BytesToString(carolAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(600), Recipient: alice})
+	require.NoError(t, err)
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(400), Recipient: bob})
+	require.NoError(t, err)
+
+	f.bankKeeper.balances[ownerAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin("unimo", 1_000))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("unimo", 100))
+
+	now := time.Unix(1_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	_, err = srv.DistributeToHolders(ctx, &types.MsgDistributeToHolders{Creator: alice, Denom: token, RewardCoins: rewards})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	distResp, err := srv.DistributeToHolders(ctx, &types.MsgDistributeToHolders{Creator: owner, Denom: token, RewardCoins: rewards})
+	require.NoError(t, err)
+	id := distResp.DistributionId
+	requireEvent(t, ctx, &types.EventDistributionCreated{
+		Id:      id,
+		Denom:   token,
+		Creator: owner,
+		Rewards: rewards,
+		Expiry:  now.Add(types.DefaultDistributionClaimPeriod * time.Second),
+	})
+
+	// Transfers after the distribution do not change the shares
+	require.NoError(t, f.bankKeeper.SendCoins(ctx, aliceAddr, carolAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 300))))
+
+	claimable, err := qs.DistributionClaimable(ctx, &types.QueryDistributionClaimableRequest{DistributionId: id, Address: bob})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unimo", 40)), claimable.Amount)
+
+	claim, err := srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: alice, DistributionId: id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unimo", 60)), claim.Amount)
+	requireEvent(t, ctx, &types.EventDistributionClaimed{DistributionId: id, Claimer: alice, Amount: claim.Amount})
+
+	_, err = srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: alice, DistributionId: id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: carol, DistributionId: id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	claim, err = srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: bob, DistributionId: id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unimo", 40)), claim.Amount)
+
+	// Fully claimed distributions stop recording balances
+	distribution, err := f.keeper.Distribution.Get(ctx, id)
+	require.NoError(t, err)
+	require.True(t, distribution.Remaining.IsZero())
+	active, err := f.keeper.ActiveDistribution.Has(ctx, collections.Join(token, id))
+	require.NoError(t, err)
+	require.False(t, active)
+}
+
+func TestDistributionExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.bankKeeper.restriction = f.keeper.SendRestrictionFn
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString(sdk.AccAddress("aliceAddr___________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(400), Recipient: alice})
+	require.NoError(t, err)
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(600), Recipient: owner})
+	require.NoError(t, err)
+
+	f.bankKeeper.balances[ownerAddr.String()] = f.bankKeeper.balances[ownerAddr.String()].Add(sdk.NewInt64Coin("unimo", 7))
+
+	params := types.DefaultParams()
+	params.MaxActiveDistributions = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	now := time.Unix(1_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	distResp, err := srv.DistributeToHolders(ctx, &types.MsgDistributeToHolders{Creator: owner, Denom: token, RewardCoins: sdk.NewCoins(sdk.NewInt64Coin("unimo", 7))})
+	require.NoError(t, err)
+	id := distResp.DistributionId
+
+	// The number of active distributions is capped
+	_, err = srv.DistributeToHolders(ctx, &types.MsgDistributeToHolders{Creator: owner, Denom: token, RewardCoins: sdk.NewCoins(sdk.NewInt64Coin(token, 1))})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Shares round down
+	claim, err := srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: alice, DistributionId: id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unimo", 2)), claim.Amount)
+
+	expiry := now.Add(types.DefaultDistributionClaimPeriod * time.Second)
+	ctx = ctx.WithBlockTime(expiry)
+
+	_, err = srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: owner, DistributionId: id})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// A failing refund is logged and the distribution kept for a later block
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	escrowed := f.bankKeeper.balances[moduleAddr]
+	f.bankKeeper.balances[moduleAddr] = nil
+	require.NoError(t, f.keeper.ProcessExpiredDistributions(ctx))
+	_, err = f.keeper.Distribution.Get(ctx, id)
+	require.NoError(t, err)
+	f.bankKeeper.balances[moduleAddr] = escrowed
+
+	// The rest returns to the owner and the distribution is removed
+	require.NoError(t, f.keeper.ProcessExpiredDistributions(ctx))
+	require.Equal(t, int64(5), f.bankKeeper.GetBalance(ctx, ownerAddr, "unimo").Amount.Int64())
+	requireEvent(t, ctx, &types.EventDistributionExpired{DistributionId: id, Creator: owner, Refunded: sdk.NewCoins(sdk.NewInt64Coin("unimo", 5))})
+
+	_, err = srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: owner, DistributionId: id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	has, err := f.keeper.DistributionClaim.Has(ctx, collections.Join(id, alice))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// A new distribution can be created once the previous one expired
+	f.bankKeeper.balances[ownerAddr.String()] = f.bankKeeper.balances[ownerAddr.String()].Add(sdk.NewInt64Coin("unimo", 7))
+	_, err = srv.DistributeToHolders(ctx, &types.MsgDistributeToHolders{Creator: owner, Denom: token, RewardCoins: sdk.NewCoins(sdk.NewInt64Coin("unimo", 7))})
+	require.NoError(t, err)
+}
+
+func TestDistributionExcludesModuleAndEscrowBalances(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.bankKeeper.restriction = f.keeper.SendRestrictionFn
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	aliceAddr := sdk.AccAddress("aliceAddr___________________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(1_000), Recipient: alice})
+	require.NoError(t, err)
+
+	// Tokens held by a module account and an ICS-20 escrow cannot claim
+	moduleAcc := authtypes.NewEmptyModuleAccount("escrowmodule")
+	f.authKeeper.SetAccount(f.ctx, moduleAcc)
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	require.NoError(t, f.keeper.RecordEscrowAddress(f.ctx, ibctransfertypes.PortID, "channel-0"))
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, aliceAddr, moduleAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(token, 200))))
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, aliceAddr, escrowAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 300))))
+
+	f.bankKeeper.balances[ownerAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin("unimo", 100))
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0).UTC())
+	distResp, err := srv.DistributeToHolders(ctx, &types.MsgDistributeToHolders{Creator: owner, Denom: token, RewardCoins: sdk.NewCoins(sdk.NewInt64Coin("unimo", 100))})
+	require.NoError(t, err)
+
+	distribution, err := f.keeper.Distribution.Get(ctx, distResp.DistributionId)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), distribution.Supply)
+
+	// Alice holds every claimable token, so she gets all the rewards
+	claimResp, err := srv.ClaimDistribution(ctx, &types.MsgClaimDistribution{Claimer: alice, DistributionId: distResp.DistributionId})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unimo", 100)), claimResp.Amount)
+}
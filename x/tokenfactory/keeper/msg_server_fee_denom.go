@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) SetFeeDenom(ctx context.Context, msg *types.MsgSetFeeDenom) (*types.MsgSetFeeDenomResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.FeeDenom.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	denom, err := k.Denom.Get(ctx, msg.FeeDenom.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// The owner backs the conversion of fees paid in the denom
+	if denom.Owner == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom has no owner")
+	}
+
+	if err := k.FeeDenom.Set(ctx, msg.FeeDenom.Denom, msg.FeeDenom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set fee denom")
+	}
+
+	// Prices are kept only while oracles price the denom
+	if len(msg.FeeDenom.Oracles) == 0 {
+		err := k.FeeDenomPrice.Clear(ctx, collections.NewPrefixedPairRange[string, time.Time](msg.FeeDenom.Denom))
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear fee denom prices")
+		}
+	} else if err := k.pruneFeeDenomPrices(ctx, msg.FeeDenom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to prune fee denom prices")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventFeeDenomSet{Denom: msg.FeeDenom.Denom}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeDenomResponse{}, nil
+}
+
+func (k msgServer) RemoveFeeDenom(ctx context.Context, msg *types.MsgRemoveFeeDenom) (*types.MsgRemoveFeeDenomResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	has, err := k.FeeDenom.Has(ctx, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !has {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "fee denom not found")
+	}
+
+	if err := k.removeFeeDenom(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove fee denom")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventFeeDenomRemoved{Denom: msg.Denom}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeDenomResponse{}, nil
+}
+
+func (k msgServer) SubmitFeeDenomPrice(ctx context.Context, msg *types.MsgSubmitFeeDenomPrice) (*types.MsgSubmitFeeDenomPriceResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Oracle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid oracle address: %s", err))
+	}
+
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+
+	feeDenom, err := k.FeeDenom.Get(ctx, msg.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "fee denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if !slices.Contains(feeDenom.Oracles, msg.Oracle) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only an oracle of the fee denom can submit prices")
+	}
+
+	// A later price in the same block replaces the earlier one
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if err := k.FeeDenomPrice.Set(ctx, collections.Join(msg.Denom, now), msg.Price); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set fee denom price")
+	}
+	if err := k.pruneFeeDenomPrices(ctx, feeDenom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to prune fee denom prices")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventFeeDenomPriceSubmitted{
+		Denom:  msg.Denom,
+		Oracle: msg.Oracle,
+		Price:  msg.Price,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitFeeDenomPriceResponse{}, nil
+}
+
+// checkAuthority returns an error unless address is the module authority.
+func (k msgServer) checkAuthority(address string) error {
+	authority, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, address)
+	}
+
+	return nil
+}
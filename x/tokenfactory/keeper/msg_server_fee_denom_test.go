@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestFeeDenomMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	oracle, err := f.addressCodec.BytesToString([]byte("oracleAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	fixed := types.FeeDenom{Denom: token, FixedRate: math.LegacyNewDecWithPrec(25, 1)}
+
+	_, err = srv.SetFeeDenom(f.ctx, &types.MsgSetFeeDenom{Authority: owner, FeeDenom: fixed})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = srv.SetFeeDenom(f.ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: types.FeeDenom{Denom: "factory/" + owner + "/missing", FixedRate: math.LegacyOneDec()}})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// A fee denom needs exactly one rate source
+	both := types.FeeDenom{Denom: token, FixedRate: math.LegacyOneDec(), Oracles: []string{oracle}, TwapWindow: 60}
+	_, err = srv.SetFeeDenom(f.ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: both})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// The TWAP window is capped
+	long := types.FeeDenom{Denom: token, Oracles: []string{oracle}, TwapWindow: types.MaxTwapWindow + 1}
+	_, err = srv.SetFeeDenom(f.ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: long})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.SetFeeDenom(f.ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: fixed})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventFeeDenomSet{Denom: token})
+
+	rate, err := f.keeper.FeeDenomRate(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 1), rate)
+
+	// Fixed rate denoms take no oracle prices
+	_, err = srv.SubmitFeeDenomPrice(f.ctx, &types.MsgSubmitFeeDenomPrice{Oracle: oracle, Denom: token, Price: math.LegacyOneDec()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RemoveFeeDenom(f.ctx, &types.MsgRemoveFeeDenom{Authority: authority, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventFeeDenomRemoved{Denom: token})
+
+	_, err = f.keeper.FeeDenomRate(f.ctx, token)
+	require.ErrorIs(t, err, types.ErrNotFeeDenom)
+
+	_, err = srv.RemoveFeeDenom(f.ctx, &types.MsgRemoveFeeDenom{Authority: authority, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestFeeDenomTWAP(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	oracle, err := f.addressCodec.BytesToString([]byte("oracleAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	start := time.Unix(1_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(start)
+
+	_, err = srv.SetFeeDenom(ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: types.FeeDenom{Denom: token, Oracles: []string{oracle}, TwapWindow: 100}})
+	require.NoError(t, err)
+
+	_, err = f.keeper.FeeDenomRate(ctx, token)
+	require.ErrorIs(t, err, types.ErrStaleFeeRate)
+
+	_, err = srv.SubmitFeeDenomPrice(ctx, &types.MsgSubmitFeeDenomPrice{Oracle: owner, Denom: token, Price: math.LegacyOneDec()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	submit := func(at time.Duration, price int64) {
+		ctx = ctx.WithBlockTime(start.Add(at))
+		_, err := srv.SubmitFeeDenomPrice(ctx, &types.MsgSubmitFeeDenomPrice{Oracle: oracle, Denom: token, Price: math.LegacyNewDec(price)})
+		require.NoError(t, err)
+	}
+
+	// A single price is the rate right away
+	submit(0, 2)
+	rate, err := f.keeper.FeeDenomRate(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), rate)
+
+	// 2 for 60s then 5 for 40s
+	submit(60*time.Second, 5)
+	rate, err = f.keeper.FeeDenomRate(ctx.WithBlockTime(start.Add(100*time.Second)), token)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(32, 1), rate)
+
+	// Once the window moves on, the price before it holds at its start: 5 for
+	// 50s then 8 for 50s
+	submit(150*time.Second, 8)
+	rate, err = f.keeper.FeeDenomRate(ctx.WithBlockTime(start.Add(200*time.Second)), token)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(65, 1), rate)
+
+	// Prices before the latest one preceding the window are pruned
+	submit(200*time.Second, 8)
+	_, err = f.keeper.FeeDenomPrice.Get(ctx, collections.Join(token, start))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = f.keeper.FeeDenomPrice.Get(ctx, collections.Join(token, start.Add(60*time.Second)))
+	require.NoError(t, err)
+
+	// Without a price in the window the rate is stale
+	_, err = f.keeper.FeeDenomRate(ctx.WithBlockTime(start.Add(301*time.Second)), token)
+	require.ErrorIs(t, err, types.ErrStaleFeeRate)
+}
+
+func TestConvertFee(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	payerAddr := sdk.AccAddress("payerAddr___________________")
+	payer, err := f.addressCodec.BytesToString(payerAddr)
+	require.NoError(t, err)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(100), Recipient: payer})
+	require.NoError(t, err)
+
+	_, err = f.keeper.ConvertFee(f.ctx, payerAddr, sdk.NewInt64Coin(token, 10), sdk.DefaultBondDenom)
+	require.ErrorIs(t, err, types.ErrNotFeeDenom)
+
+	_, err = srv.SetFeeDenom(f.ctx, &types.MsgSetFeeDenom{Authority: authority, FeeDenom: types.FeeDenom{Denom: token, FixedRate: math.LegacyNewDecWithPrec(25, 1)}})
+	require.NoError(t, err)
+
+	f.bankKeeper.balances[ownerAddr.String()] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))
+	value, err := f.keeper.ConvertFee(f.ctx, payerAddr, sdk.NewInt64Coin(token, 10), sdk.DefaultBondDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 25), value)
+
+	// The fee collector only receives the base denom
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)), f.bankKeeper.balances[feeCollector.String()])
+	require.Equal(t, math.NewInt(90), f.bankKeeper.GetBalance(f.ctx, payerAddr, token).Amount)
+	require.Equal(t, math.NewInt(10), f.bankKeeper.GetBalance(f.ctx, ownerAddr, token).Amount)
+	require.Equal(t, math.NewInt(5), f.bankKeeper.GetBalance(f.ctx, ownerAddr, sdk.DefaultBondDenom).Amount)
+
+	// The owner needs the base denom to back the conversion
+	_, err = f.keeper.ConvertFee(f.ctx, payerAddr, sdk.NewInt64Coin(token, 10), sdk.DefaultBondDenom)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// A fee worth less than one unit of the base denom is not converted
+	_, err = f.keeper.ConvertFee(f.ctx, payerAddr, sdk.NewInt64Coin(token, 0), sdk.DefaultBondDenom)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) ForceTransfer(ctx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	fromAddr, err := k.addressCodec.StringToBytes(msg.From)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid from address: %s", err))
+	}
+
+	toAddr, err := k.addressCodec.StringToBytes(msg.To)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid to address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, msg.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if !denom.ClawbackEnabled {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "denom was created without clawback")
+	}
+
+	// The owner can always claw back, other signers need the clawback role
+	if msg.Creator != denom.Owner {
+		if _, err := k.requireRole(ctx, msg.Denom, msg.Creator, types.ROLE_CLAWBACK); err != nil {
+			return nil, err
+		}
+	}
+
+	// Module accounts and IBC escrows hold tokens on behalf of others, so
+	// clawing back from them would break their accounting
+	isEscrow, err := k.isEscrowAddress(ctx, fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	if isEscrow || k.isModuleAccount(ctx, fromAddr) || k.bankKeeper.BlockedAddr(fromAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot force transfer from %s", msg.From)
+	}
+
+	if k.bankKeeper.BlockedAddr(toAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.To)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Amount))
+	if err := k.bankKeeper.SendCoins(withForceTransfer(ctx), fromAddr, toAddr, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventForceTransfer{
+		Denom:    msg.Denom,
+		Operator: msg.Creator,
+		From:     msg.From,
+		To:       msg.To,
+		Amount:   msg.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceTransferResponse{}, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestForceTransferMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.bankKeeper.restriction = f.keeper.SendRestrictionFn
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	holderAddr := sdk.AccAddress("holderAddr__________________")
+	holder, err := f.addressCodec.BytesToString(holderAddr)
+	require.NoError(t, err)
+	recoveryAddr := sdk.AccAddress("recoveryAddr________________")
+	recovery, err := f.addressCodec.BytesToString(recoveryAddr)
+	require.NoError(t, err)
+	operator, err := f.addressCodec.BytesToString([]byte("operatorAddr________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "usd", Description: "Dollar", MaxSupply: math.NewInt(1_000), ClawbackEnabled: true})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	// Only denoms without clawback carry the notice
+	require.Equal(t, "Dollar", f.bankKeeper.metadata[token].Description)
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(100), Recipient: holder})
+	require.NoError(t, err)
+
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: operator, Denom: token, From: holder, To: recovery, Amount: math.NewInt(10)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: "unknown", From: holder, To: recovery, Amount: math.NewInt(10)})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Frozen accounts and a paused denom do not stop a clawback
+	_, err = srv.FreezeAccount(f.ctx, &types.MsgFreezeAccount{Creator: owner, Denom: token, Address: holder})
+	require.NoError(t, err)
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: token, From: holder, To: recovery, Amount: math.NewInt(10)})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventForceTransfer{Denom: token, Operator: owner, From: holder, To: recovery, Amount: math.NewInt(10)})
+	require.Equal(t, math.NewInt(90), f.bankKeeper.GetBalance(f.ctx, holderAddr, token).Amount)
+	require.Equal(t, math.NewInt(10), f.bankKeeper.GetBalance(f.ctx, recoveryAddr, token).Amount)
+
+	// Regular transfers stay restricted
+	err = f.bankKeeper.SendCoins(f.ctx, holderAddr, recoveryAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 1)))
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	// Holders of the clawback role can force transfers too
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: operator, Role: types.ROLE_CLAWBACK})
+	require.NoError(t, err)
+
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: operator, Denom: token, From: holder, To: recovery, Amount: math.NewInt(90)})
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.GetBalance(f.ctx, holderAddr, token).Amount.IsZero())
+
+	// Blocked addresses cannot receive
+	f.bankKeeper.blocked[holderAddr.String()] = true
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: token, From: recovery, To: holder, Amount: math.NewInt(1)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	delete(f.bankKeeper.blocked, holderAddr.String())
+
+	// Nor can tokens be taken from blocked, module or escrow accounts
+	f.bankKeeper.blocked[recoveryAddr.String()] = true
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: token, From: recovery, To: holder, Amount: math.NewInt(1)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	delete(f.bankKeeper.blocked, recoveryAddr.String())
+
+	moduleAcc := authtypes.NewEmptyModuleAccount("escrowmodule")
+	f.authKeeper.SetAccount(f.ctx, moduleAcc)
+	module, err := f.addressCodec.BytesToString(moduleAcc.GetAddress())
+	require.NoError(t, err)
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: token, From: module, To: holder, Amount: math.NewInt(1)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	for _, id := range []string{"channel-0", "07-tendermint-0"} {
+		require.NoError(t, f.keeper.RecordEscrowAddress(f.ctx, ibctransfertypes.PortID, id))
+		escrow, err := f.addressCodec.BytesToString(ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, id))
+		require.NoError(t, err)
+		_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: token, From: escrow, To: holder, Amount: math.NewInt(1)})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	}
+}
+
+func TestForceTransferWithoutClawback(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(100), Recipient: holder})
+	require.NoError(t, err)
+
+	_, err = srv.ForceTransfer(f.ctx, &types.MsgForceTransfer{Creator: owner, Denom: token, From: holder, To: owner, Amount: math.NewInt(10)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The clawback role cannot be handed out either
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: holder, Role: types.ROLE_CLAWBACK})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	require.Equal(t, types.ClawbackDisabledNotice, f.bankKeeper.metadata[token].Description)
+}
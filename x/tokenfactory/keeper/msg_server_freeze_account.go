@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) FreezeAccount(ctx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	key, err := k.frozenAccountKey(ctx, msg.Creator, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := k.FrozenAccount.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if frozen {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "account already frozen")
+	}
+
+	if err := k.FrozenAccount.Set(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to freeze account")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventAccountFrozen{Denom: key.K1(), Address: key.K2()}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (k msgServer) UnfreezeAccount(ctx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	key, err := k.frozenAccountKey(ctx, msg.Creator, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := k.FrozenAccount.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !frozen {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "account is not frozen")
+	}
+
+	if err := k.FrozenAccount.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to unfreeze account")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventAccountUnfrozen{Denom: key.K1(), Address: key.K2()}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+// frozenAccountKey checks that creator owns denom and returns the key of
+// address in the FrozenAccount set.
+func (k msgServer) frozenAccountKey(ctx context.Context, creator, denomName, address string) (collections.Pair[string, string], error) {
+	var key collections.Pair[string, string]
+
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	// Store the canonical encoding so lookups from the send restriction match
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if address, err = k.addressCodec.BytesToString(addr); err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, denomName)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return key, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return key, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if creator != denom.Owner {
+		return key, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can freeze or unfreeze accounts")
+	}
+
+	return collections.Join(denomName, address), nil
+}
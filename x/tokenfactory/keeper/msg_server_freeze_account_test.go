@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestFreezeAccountMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	holderAddr := sdk.AccAddress("holderAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString(holderAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.FreezeAccount(f.ctx, &types.MsgFreezeAccount{Creator: holder, Denom: token, Address: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.FreezeAccount(f.ctx, &types.MsgFreezeAccount{Creator: owner, Denom: "unknown", Address: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.FreezeAccount(f.ctx, &types.MsgFreezeAccount{Creator: owner, Denom: token, Address: holder})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventAccountFrozen{Denom: token, Address: holder})
+
+	_, err = srv.FreezeAccount(f.ctx, &types.MsgFreezeAccount{Creator: owner, Denom: token, Address: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// The frozen account can neither send nor receive the denom
+	coins := sdk.NewCoins(sdk.NewInt64Coin(token, 1))
+	_, err = f.keeper.SendRestrictionFn(f.ctx, holderAddr, ownerAddr, coins)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, coins)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Other denoms are unaffected
+	_, err = f.keeper.SendRestrictionFn(f.ctx, holderAddr, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.NoError(t, err)
+
+	frozen, err := qs.FrozenAccounts(f.ctx, &types.QueryFrozenAccountsRequest{Denom: token})
+	require.NoError(t, err)
+	require.Equal(t, []string{holder}, frozen.Addresses)
+
+	isFrozen, err := qs.IsFrozen(f.ctx, &types.QueryIsFrozenRequest{Denom: token, Address: holder})
+	require.NoError(t, err)
+	require.True(t, isFrozen.Frozen)
+
+	_, err = srv.UnfreezeAccount(f.ctx, &types.MsgUnfreezeAccount{Creator: holder, Denom: token, Address: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UnfreezeAccount(f.ctx, &types.MsgUnfreezeAccount{Creator: owner, Denom: token, Address: holder})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventAccountUnfrozen{Denom: token, Address: holder})
+
+	_, err = srv.UnfreezeAccount(f.ctx, &types.MsgUnfreezeAccount{Creator: owner, Denom: token, Address: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = f.keeper.SendRestrictionFn(f.ctx, holderAddr, ownerAddr, coins)
+	require.NoError(t, err)
+
+	frozen, err = qs.FrozenAccounts(f.ctx, &types.QueryFrozenAccountsRequest{Denom: token})
+	require.NoError(t, err)
+	require.Empty(t, frozen.Addresses)
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMintAndSendTokensMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	recipient, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	// One million whole tokens at 18 decimals, well beyond int64
+	oneToken := math.NewIntWithDecimal(1, 18)
+	maxSupply := oneToken.MulRaw(1_000_000)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", Precision: 18, MaxSupply: maxSupply})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: oneToken.MulRaw(100), Recipient: recipient})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventMint{Denom: token, Minter: owner, Recipient: recipient, Amount: oneToken.MulRaw(100)})
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: maxSupply, Recipient: recipient})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, oneToken.MulRaw(100), denom.Supply)
+	require.Equal(t, oneToken.MulRaw(100), f.bankKeeper.supply.AmountOf(token))
+
+	// Supply at the math.Int bound must not wrap around
+	maxInt := math.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen), big.NewInt(1)))
+	denom.Supply = maxInt
+	denom.MaxSupply = maxInt
+	require.NoError(t, f.keeper.Denom.Set(f.ctx, token, denom))
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.OneInt(), Recipient: recipient})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
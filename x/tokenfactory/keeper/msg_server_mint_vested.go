@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) MintVested(ctx context.Context, msg *types.MsgMintVested) (*types.MsgMintVestedResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	recipientAddr, err := k.addressCodec.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+
+	// Like x/auth/vesting, only a new account can be made a vesting account
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+	if k.authKeeper.GetAccount(ctx, recipientAddr) != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.Recipient)
+	}
+
+	startTime := msg.StartTime
+	if startTime == 0 {
+		startTime = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	}
+	if len(msg.Periods) == 0 && msg.EndTime <= startTime {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "end time must be after start time")
+	}
+
+	coins, err := k.mintToModule(ctx, msg.Creator, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(recipientAddr)
+	baseAccount = k.authKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+
+	var vestingAccount vestingexported.VestingAccount
+	if len(msg.Periods) == 0 {
+		vestingAccount, err = vestingtypes.NewContinuousVestingAccount(baseAccount, coins, startTime, msg.EndTime)
+	} else {
+		periods := make(vestingtypes.Periods, len(msg.Periods))
+		for i, period := range msg.Periods {
+			periods[i] = vestingtypes.Period{
+				Length: period.Length,
+				Amount: sdk.NewCoins(sdk.NewCoin(msg.Denom, period.Amount)),
+			}
+		}
+		vestingAccount, err = vestingtypes.NewPeriodicVestingAccount(baseAccount, coins, startTime, periods)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.authKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to send coins: %s", err))
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventMintVested{
+		Denom:     msg.Denom,
+		Minter:    msg.Creator,
+		Recipient: msg.Recipient,
+		Amount:    msg.Amount,
+		StartTime: startTime,
+		EndTime:   vestingAccount.GetEndTime(),
+		Periodic:  len(msg.Periods) > 0,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintVestedResponse{}, nil
+}
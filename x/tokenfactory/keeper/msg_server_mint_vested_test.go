@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMintVestedMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_000, 0))
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	teamAddr := sdk.AccAddress("teamAddr____________________")
+	team, err := f.addressCodec.BytesToString(teamAddr)
+	require.NoError(t, err)
+	investorAddr := sdk.AccAddress("investorAddr________________")
+	investor, err := f.addressCodec.BytesToString(investorAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintVested(ctx, &types.MsgMintVested{Creator: team, Denom: token, Recipient: team, Amount: math.NewInt(100), EndTime: 2_000})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The schedule must end after the block time when it starts now
+	_, err = srv.MintVested(ctx, &types.MsgMintVested{Creator: owner, Denom: token, Recipient: team, Amount: math.NewInt(100), EndTime: 500})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Continuous vesting starting at the block time
+	_, err = srv.MintVested(ctx, &types.MsgMintVested{Creator: owner, Denom: token, Recipient: team, Amount: math.NewInt(100), EndTime: 2_000})
+	require.NoError(t, err)
+	requireEvent(t, ctx, &types.EventMintVested{Denom: token, Minter: owner, Recipient: team, Amount: math.NewInt(100), StartTime: 1_000, EndTime: 2_000})
+
+	continuous, ok := f.authKeeper.GetAccount(ctx, teamAddr).(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(token, 100)), continuous.OriginalVesting)
+	require.Equal(t, int64(1_000), continuous.StartTime)
+	require.Equal(t, int64(2_000), continuous.EndTime)
+	require.Equal(t, math.NewInt(100), f.bankKeeper.GetBalance(ctx, teamAddr, token).Amount)
+
+	// Only new accounts can receive a vesting mint
+	_, err = srv.MintVested(ctx, &types.MsgMintVested{Creator: owner, Denom: token, Recipient: team, Amount: math.NewInt(100), EndTime: 2_000})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Periodic vesting
+	_, err = srv.MintVested(ctx, &types.MsgMintVested{
+		Creator:   owner,
+		Denom:     token,
+		Recipient: investor,
+		Amount:    math.NewInt(300),
+		StartTime: 1_500,
+		Periods: []types.VestingPeriod{
+			{Length: 100, Amount: math.NewInt(100)},
+			{Length: 200, Amount: math.NewInt(200)},
+		},
+	})
+	require.NoError(t, err)
+	requireEvent(t, ctx, &types.EventMintVested{Denom: token, Minter: owner, Recipient: investor, Amount: math.NewInt(300), StartTime: 1_500, EndTime: 1_800, Periodic: true})
+
+	periodic, ok := f.authKeeper.GetAccount(ctx, investorAddr).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(token, 300)), periodic.OriginalVesting)
+	require.Len(t, periodic.VestingPeriods, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(token, 100)), periodic.GetVestedCoins(time.Unix(1_600, 0)))
+
+	denom, err := f.keeper.Denom.Get(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(400), denom.Supply)
+}
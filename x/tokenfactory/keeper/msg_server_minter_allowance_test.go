@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestSetMinterAllowanceMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	minter, err := f.addressCodec.BytesToString([]byte("minterAddr__________________"))
+	require.NoError(t, err)
+	recipient, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	total, perEpoch := math.NewInt(100), math.NewInt(40)
+
+	// The minter role is required first
+	_, err = srv.SetMinterAllowance(f.ctx, &types.MsgSetMinterAllowance{Creator: owner, Denom: token, Minter: minter, TotalAllowance: &total, PerEpochLimit: &perEpoch})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: minter, Role: types.ROLE_MINTER})
+	require.NoError(t, err)
+
+	_, err = srv.SetMinterAllowance(f.ctx, &types.MsgSetMinterAllowance{Creator: minter, Denom: token, Minter: minter, TotalAllowance: &total, PerEpochLimit: &perEpoch})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetMinterAllowance(f.ctx, &types.MsgSetMinterAllowance{Creator: owner, Denom: token, Minter: minter, TotalAllowance: &total, PerEpochLimit: &perEpoch})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventMinterAllowanceSet{Denom: token, Minter: minter, TotalAllowance: &total, PerEpochLimit: &perEpoch})
+
+	mint := func(amount int64) error {
+		_, err := srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: minter, Denom: token, Amount: math.NewInt(amount), Recipient: recipient})
+		return err
+	}
+
+	require.NoError(t, mint(30))
+	require.ErrorIs(t, mint(11), sdkerrors.ErrUnauthorized)
+	require.NoError(t, mint(10))
+
+	allowance, err := qs.MinterAllowance(f.ctx, &types.QueryMinterAllowanceRequest{Denom: token, Minter: minter})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), *allowance.RemainingTotal)
+	require.Equal(t, math.ZeroInt(), *allowance.RemainingInEpoch)
+	require.Equal(t, math.NewInt(40), allowance.MintedInEpoch)
+
+	// Only the configured epoch resets the counters
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Hooks().AfterEpochEnd(f.ctx, "other", 1))
+	require.ErrorIs(t, mint(1), sdkerrors.ErrUnauthorized)
+	require.NoError(t, f.keeper.Hooks().AfterEpochEnd(f.ctx, params.MintEpochIdentifier, 1))
+
+	require.NoError(t, mint(40))
+	require.NoError(t, f.keeper.Hooks().AfterEpochEnd(f.ctx, params.MintEpochIdentifier, 2))
+
+	// The total allowance still applies across epochs
+	require.ErrorIs(t, mint(21), sdkerrors.ErrUnauthorized)
+	require.NoError(t, mint(20))
+
+	allowance, err = qs.MinterAllowance(f.ctx, &types.QueryMinterAllowanceRequest{Denom: token, Minter: minter})
+	require.NoError(t, err)
+	require.True(t, allowance.RemainingTotal.IsZero())
+
+	// Removing the limits lets the minter mint freely again
+	_, err = srv.SetMinterAllowance(f.ctx, &types.MsgSetMinterAllowance{Creator: owner, Denom: token, Minter: minter})
+	require.NoError(t, err)
+	require.NoError(t, mint(100))
+
+	allowance, err = qs.MinterAllowance(f.ctx, &types.QueryMinterAllowanceRequest{Denom: token, Minter: minter})
+	require.NoError(t, err)
+	require.Nil(t, allowance.RemainingTotal)
+	require.Nil(t, allowance.RemainingInEpoch)
+}
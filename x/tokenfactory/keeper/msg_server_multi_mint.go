@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) MultiMint(ctx context.Context, msg *types.MsgMultiMint) (*types.MsgMultiMintResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if uint64(len(msg.Outputs)) > params.MaxMultiMintRecipients {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d recipients per multi mint", params.MaxMultiMintRecipients)
+	}
+
+	outputs := make([]banktypes.Output, 0, len(msg.Outputs))
+	total := math.ZeroInt()
+	for _, output := range msg.Outputs {
+		recipientAddr, err := k.addressCodec.StringToBytes(output.Recipient)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+		}
+		if k.bankKeeper.BlockedAddr(recipientAddr) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", output.Recipient)
+		}
+
+		if total, err = total.SafeAdd(output.Amount); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("supply overflow: %s", err))
+		}
+		outputs = append(outputs, banktypes.Output{
+			Address: output.Recipient,
+			Coins:   sdk.NewCoins(sdk.NewCoin(msg.Denom, output.Amount)),
+		})
+	}
+
+	// The max supply and mint limits are checked once for the whole batch
+	coins, err := k.mintToModule(ctx, msg.Creator, msg.Denom, total)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddr, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.bankKeeper.InputOutputCoins(ctx, banktypes.Input{Address: moduleAddr, Coins: coins}, outputs); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to send coins: %s", err))
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventMultiMint{
+		Denom:          msg.Denom,
+		Minter:         msg.Creator,
+		RecipientCount: uint64(len(msg.Outputs)),
+		Amount:         total,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiMintResponse{}, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMultiMintMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	minterAddr := sdk.AccAddress("minterAddr__________________")
+	minter, err := f.addressCodec.BytesToString(minterAddr)
+	require.NoError(t, err)
+	aliceAddr := sdk.AccAddress("aliceAddr___________________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+	bobAddr := sdk.AccAddress("bobAddr_____________________")
+	bob, err := f.addressCodec.BytesToString(bobAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	outputs := []types.MintOutput{
+		{Recipient: alice, Amount: math.NewInt(10)},
+		{Recipient: bob, Amount: math.NewInt(20)},
+	}
+
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: minter, Denom: token, Outputs: outputs})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: owner, Denom: "unknown", Outputs: outputs})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: owner, Denom: token, Outputs: outputs})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventMultiMint{Denom: token, Minter: owner, RecipientCount: 2, Amount: math.NewInt(30)})
+
+	require.Equal(t, math.NewInt(10), f.bankKeeper.GetBalance(f.ctx, aliceAddr, token).Amount)
+	require.Equal(t, math.NewInt(20), f.bankKeeper.GetBalance(f.ctx, bobAddr, token).Amount)
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(30), denom.Supply)
+	require.Equal(t, math.NewInt(30), f.bankKeeper.supply.AmountOf(token))
+
+	// The max supply applies to the total of the batch
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: owner, Denom: token, Outputs: []types.MintOutput{
+		{Recipient: alice, Amount: math.NewInt(40)},
+		{Recipient: bob, Amount: math.NewInt(40)},
+	}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// A minter allowance is charged with the total once
+	allowance := math.NewInt(35)
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: minter, Role: types.ROLE_MINTER, MintAllowance: &allowance})
+	require.NoError(t, err)
+
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: minter, Denom: token, Outputs: outputs})
+	require.NoError(t, err)
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: minter, Denom: token, Outputs: outputs})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Blocked addresses cannot receive
+	f.bankKeeper.blocked[bobAddr.String()] = true
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: owner, Denom: token, Outputs: outputs})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	delete(f.bankKeeper.blocked, bobAddr.String())
+
+	// The number of recipients is capped by the params
+	params := types.DefaultParams()
+	params.MaxMultiMintRecipients = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: owner, Denom: token, Outputs: outputs})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	denom, err = f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), denom.Supply)
+}
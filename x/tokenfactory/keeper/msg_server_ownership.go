@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) ProposeOwner(ctx context.Context, msg *types.MsgProposeOwner) (*types.MsgProposeOwnerResponse, error) {
+	denom, err := k.ownedDenom(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new owner address: %s", err))
+	}
+
+	if msg.NewOwner == denom.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new owner is already the owner")
+	}
+
+	if msg.Expiry != nil && !msg.Expiry.After(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiry must be in the future")
+	}
+
+	proposal := types.OwnershipProposal{
+		Denom:         msg.Denom,
+		ProposedOwner: msg.NewOwner,
+		Expiry:        msg.Expiry,
+	}
+	if err := k.setOwnershipProposal(ctx, proposal); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set ownership proposal")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventOwnershipProposed{
+		Denom:         msg.Denom,
+		Owner:         msg.Creator,
+		ProposedOwner: msg.NewOwner,
+		Expiry:        msg.Expiry,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeOwnerResponse{}, nil
+}
+
+func (k msgServer) AcceptOwnership(ctx context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new owner address: %s", err))
+	}
+
+	proposal, err := k.OwnershipProposal.Get(ctx, msg.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "no pending ownership proposal")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.NewOwner != proposal.ProposedOwner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the proposed owner can accept ownership")
+	}
+
+	if proposal.Expiry != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*proposal.Expiry) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "ownership proposal has expired")
+	}
+
+	denom, err := k.Denom.Get(ctx, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := k.transferOwnership(ctx, denom, msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to transfer ownership")
+	}
+
+	return &types.MsgAcceptOwnershipResponse{}, nil
+}
+
+func (k msgServer) CancelOwnershipProposal(ctx context.Context, msg *types.MsgCancelOwnershipProposal) (*types.MsgCancelOwnershipProposalResponse, error) {
+	if _, err := k.ownedDenom(ctx, msg.Creator, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	found, err := k.OwnershipProposal.Has(ctx, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "no pending ownership proposal")
+	}
+
+	if err := k.removeOwnershipProposal(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to cancel ownership proposal")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventOwnershipProposalCancelled{Denom: msg.Denom, Owner: msg.Creator}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOwnershipProposalResponse{}, nil
+}
+
+func (k msgServer) RenounceOwnership(ctx context.Context, msg *types.MsgRenounceOwnership) (*types.MsgRenounceOwnershipResponse, error) {
+	denom, err := k.ownedDenom(ctx, msg.Creator, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// A paused denom could never be unpaused again
+	if denom.Paused {
+		return nil, errorsmod.Wrap(types.ErrDenomPaused, "cannot renounce ownership of a paused denom")
+	}
+
+	// Without an owner and roles no one can mint or change the metadata.
+	// Freezes, the before-send hook and the transfer fee could never be lifted
+	// again, so they go as well.
+	denom.Owner = ""
+	denom.CanChangeMaxSupply = false
+	denom.TransferFee = nil
+	if err := k.Denom.Set(ctx, msg.Denom, denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update denom")
+	}
+
+	if err := k.RoleGrant.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear role grants")
+	}
+	if err := k.EpochMint.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear epoch mints")
+	}
+	if err := k.FrozenAccount.Clear(ctx, collections.NewPrefixedPairRange[string, string](msg.Denom)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear frozen accounts")
+	}
+	if err := k.BeforeSendHook.Remove(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove before send hook")
+	}
+	if err := k.removeOwnershipProposal(ctx, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove ownership proposal")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventOwnerChanged{Denom: msg.Denom, PreviousOwner: msg.Creator, NewOwner: ""}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenounceOwnershipResponse{}, nil
+}
+
+// ownedDenom returns denom after checking that creator is its owner.
+func (k msgServer) ownedDenom(ctx context.Context, creator, denomName string) (types.Denom, error) {
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return types.Denom{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, denomName)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return denom, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return denom, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if creator != denom.Owner {
+		return denom, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can manage ownership")
+	}
+
+	return denom, nil
+}
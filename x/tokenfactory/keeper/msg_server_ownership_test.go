@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestOwnershipMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100), CanChangeMaxSupply: true})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.ProposeOwner(f.ctx, &types.MsgProposeOwner{Creator: other, Denom: token, NewOwner: newOwner})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ProposeOwner(f.ctx, &types.MsgProposeOwner{Creator: owner, Denom: token, NewOwner: owner})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ProposeOwner(f.ctx, &types.MsgProposeOwner{Creator: owner, Denom: token, NewOwner: newOwner})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventOwnershipProposed{Denom: token, Owner: owner, ProposedOwner: newOwner})
+
+	// Proposing does not move ownership
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, owner, denom.Owner)
+
+	proposal, err := qs.OwnershipProposal(f.ctx, &types.QueryOwnershipProposalRequest{Denom: token})
+	require.NoError(t, err)
+	require.Equal(t, newOwner, proposal.OwnershipProposal.ProposedOwner)
+
+	_, err = srv.AcceptOwnership(f.ctx, &types.MsgAcceptOwnership{NewOwner: other, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.AcceptOwnership(f.ctx, &types.MsgAcceptOwnership{NewOwner: newOwner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventOwnerChanged{Denom: token, PreviousOwner: owner, NewOwner: newOwner})
+
+	denom, err = f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, denom.Owner)
+
+	// Roles moved with ownership
+	roles, err := qs.AccountRoles(f.ctx, &types.QueryAccountRolesRequest{Denom: token, Address: newOwner})
+	require.NoError(t, err)
+	require.Len(t, roles.RoleGrant.Roles, len(types.AllRoles))
+	_, err = f.keeper.RoleGrant.Get(f.ctx, collections.Join(token, owner))
+	require.Error(t, err)
+
+	_, err = qs.OwnershipProposal(f.ctx, &types.QueryOwnershipProposalRequest{Denom: token})
+	require.Error(t, err)
+
+	// Cancel drops the pending proposal
+	_, err = srv.ProposeOwner(f.ctx, &types.MsgProposeOwner{Creator: newOwner, Denom: token, NewOwner: other})
+	require.NoError(t, err)
+	_, err = srv.CancelOwnershipProposal(f.ctx, &types.MsgCancelOwnershipProposal{Creator: newOwner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventOwnershipProposalCancelled{Denom: token, Owner: newOwner})
+	_, err = srv.CancelOwnershipProposal(f.ctx, &types.MsgCancelOwnershipProposal{Creator: newOwner, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.AcceptOwnership(f.ctx, &types.MsgAcceptOwnership{NewOwner: other, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestOwnershipProposalExpiry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr________________"))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	resp, err := srv.CreateDenom(ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	past := now.Add(-time.Hour)
+	_, err = srv.ProposeOwner(ctx, &types.MsgProposeOwner{Creator: owner, Denom: token, NewOwner: newOwner, Expiry: &past})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	expiry := now.Add(time.Hour)
+	_, err = srv.ProposeOwner(ctx, &types.MsgProposeOwner{Creator: owner, Denom: token, NewOwner: newOwner, Expiry: &expiry})
+	require.NoError(t, err)
+
+	// Accepting at the expiry is too late
+	ctx = ctx.WithBlockTime(expiry)
+	_, err = srv.AcceptOwnership(ctx, &types.MsgAcceptOwnership{NewOwner: newOwner, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Pruning before the expiry keeps the proposal, pruning at it removes it
+	require.NoError(t, f.keeper.PruneExpiredOwnershipProposals(ctx.WithBlockTime(now)))
+	found, err := f.keeper.OwnershipProposal.Has(ctx, token)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.NoError(t, f.keeper.PruneExpiredOwnershipProposals(ctx))
+	found, err = f.keeper.OwnershipProposal.Has(ctx, token)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	queued, err := f.keeper.OwnershipExpiryQueue.Has(ctx, collections.Join(expiry, token))
+	require.NoError(t, err)
+	require.False(t, queued)
+}
+
+func TestRenounceOwnership(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100), CanChangeMaxSupply: true})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	_, err = srv.RenounceOwnership(f.ctx, &types.MsgRenounceOwnership{Creator: owner, Denom: token})
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+
+	// Restrictions no one could lift anymore are dropped
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+	_, err = srv.FreezeAccount(f.ctx, &types.MsgFreezeAccount{Creator: owner, Denom: token, Address: holder})
+	require.NoError(t, err)
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token, BasisPoints: 100, Treasury: owner})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BeforeSendHook.Set(f.ctx, token, holder))
+
+	_, err = srv.RenounceOwnership(f.ctx, &types.MsgRenounceOwnership{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventOwnerChanged{Denom: token, PreviousOwner: owner})
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Empty(t, denom.Owner)
+	require.False(t, denom.CanChangeMaxSupply)
+	require.Nil(t, denom.TransferFee)
+
+	frozen, err := f.keeper.FrozenAccount.Has(f.ctx, collections.Join(token, holder))
+	require.NoError(t, err)
+	require.False(t, frozen)
+	hooked, err := f.keeper.BeforeSendHook.Has(f.ctx, token)
+	require.NoError(t, err)
+	require.False(t, hooked)
+
+	// Supply and metadata are now immutable
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.OneInt(), Recipient: owner})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{Owner: owner, Denom: token, Description: "changed", MaxSupply: math.NewInt(100)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ProposeOwner(f.ctx, &types.MsgProposeOwner{Creator: owner, Denom: token, NewOwner: owner})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) PauseDenom(ctx context.Context, msg *types.MsgPauseDenom) (*types.MsgPauseDenomResponse, error) {
+	if err := k.setPaused(ctx, msg.Creator, msg.Denom, true); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDenomPaused{Denom: msg.Denom, Pauser: msg.Creator}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseDenomResponse{}, nil
+}
+
+func (k msgServer) UnpauseDenom(ctx context.Context, msg *types.MsgUnpauseDenom) (*types.MsgUnpauseDenomResponse, error) {
+	if err := k.setPaused(ctx, msg.Creator, msg.Denom, false); err != nil {
+		return nil, err
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventDenomUnpaused{Denom: msg.Denom, Pauser: msg.Creator}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseDenomResponse{}, nil
+}
+
+// setPaused flips the paused flag of denom. Pausing needs the pauser role,
+// while only the owner can lift a pause, so a pauser cannot undo a pause the
+// owner put in place.
+func (k msgServer) setPaused(ctx context.Context, creator, denomName string, paused bool) error {
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, denomName)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if paused {
+		if _, err := k.requireRole(ctx, denomName, creator, types.ROLE_PAUSER); err != nil {
+			return err
+		}
+	} else if creator != denom.Owner {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can unpause the denom")
+	}
+
+	if denom.Paused == paused {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("denom paused is already %t", paused))
+	}
+
+	denom.Paused = paused
+	if err := k.Denom.Set(ctx, denomName, denom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update denom")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestPauseDenomMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	ownerAddr := sdk.AccAddress("signerAddr__________________")
+	holderAddr := sdk.AccAddress("holderAddr__________________")
+	owner, err := f.addressCodec.BytesToString(ownerAddr)
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString(holderAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: holder, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: owner, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventDenomPaused{Denom: token, Pauser: owner})
+
+	paused, err := qs.IsPaused(f.ctx, &types.QueryIsPausedRequest{Denom: token})
+	require.NoError(t, err)
+	require.True(t, paused.Paused)
+
+	// Transfers and mints fail while paused
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 1)))
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(1), Recipient: holder})
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	// Updating the denom keeps it paused
+	_, err = srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+	paused, err = qs.IsPaused(f.ctx, &types.QueryIsPausedRequest{Denom: token})
+	require.NoError(t, err)
+	require.True(t, paused.Paused)
+
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: holder, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// A pauser can pause but cannot lift the pause
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: holder, Role: types.ROLE_PAUSER})
+	require.NoError(t, err)
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: holder, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventDenomUnpaused{Denom: token, Pauser: owner})
+
+	_, err = f.keeper.SendRestrictionFn(f.ctx, ownerAddr, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 1)))
+	require.NoError(t, err)
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(1), Recipient: holder})
+	require.NoError(t, err)
+}
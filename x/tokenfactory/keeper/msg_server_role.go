@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) GrantRole(ctx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	key, err := k.roleGrantKey(ctx, msg.Creator, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Role == types.ROLE_CLAWBACK {
+		denom, err := k.Denom.Get(ctx, key.K1())
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if !denom.ClawbackEnabled {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom was created without clawback")
+		}
+	}
+
+	grant, err := k.RoleGrant.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		grant = types.RoleGrant{Denom: key.K1(), Address: key.K2()}
+	}
+
+	grant.AddRole(msg.Role)
+	// Granting the minter role again replaces its allowance
+	if msg.Role == types.ROLE_MINTER {
+		grant.MintAllowance = msg.MintAllowance
+	}
+
+	if err := k.RoleGrant.Set(ctx, key, grant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to grant role")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventRoleGranted{Denom: key.K1(), Address: key.K2(), Role: msg.Role}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (k msgServer) RevokeRole(ctx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	key, err := k.roleGrantKey(ctx, msg.Creator, msg.Denom, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := k.RoleGrant.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !grant.HasRole(msg.Role) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("%s does not hold %s", key.K2(), msg.Role))
+	}
+
+	grant.RemoveRole(msg.Role)
+	if len(grant.Roles) == 0 {
+		err = k.RoleGrant.Remove(ctx, key)
+	} else {
+		err = k.RoleGrant.Set(ctx, key, grant)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to revoke role")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventRoleRevoked{Denom: key.K1(), Address: key.K2(), Role: msg.Role}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (k msgServer) SetMinterAllowance(ctx context.Context, msg *types.MsgSetMinterAllowance) (*types.MsgSetMinterAllowanceResponse, error) {
+	key, err := k.roleGrantKey(ctx, msg.Creator, msg.Denom, msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := k.RoleGrant.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !grant.HasRole(types.ROLE_MINTER) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("%s does not hold %s", key.K2(), types.ROLE_MINTER))
+	}
+
+	// The amount already minted this epoch keeps counting against the new limit
+	grant.MintAllowance = msg.TotalAllowance
+	grant.PerEpochLimit = msg.PerEpochLimit
+	if err := k.RoleGrant.Set(ctx, key, grant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set minter allowance")
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.EventMinterAllowanceSet{
+		Denom:          key.K1(),
+		Minter:         key.K2(),
+		TotalAllowance: msg.TotalAllowance,
+		PerEpochLimit:  msg.PerEpochLimit,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMinterAllowanceResponse{}, nil
+}
+
+// roleGrantKey checks that creator owns denom and returns the key of address
+// in the RoleGrant map.
+func (k msgServer) roleGrantKey(ctx context.Context, creator, denomName, address string) (collections.Pair[string, string], error) {
+	var key collections.Pair[string, string]
+
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	// Store the canonical encoding so role checks against signers match
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if address, err = k.addressCodec.BytesToString(addr); err != nil {
+		return key, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, denomName)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return key, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return key, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if creator != denom.Owner {
+		return key, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can grant or revoke roles")
+	}
+
+	return collections.Join(denomName, address), nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestRoleMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	operator, err := f.addressCodec.BytesToString([]byte("operatorAddr________________"))
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	// The creator holds every role
+	ownerRoles, err := qs.AccountRoles(f.ctx, &types.QueryAccountRolesRequest{Denom: token, Address: owner})
+	require.NoError(t, err)
+	require.ElementsMatch(t, types.AllRoles, ownerRoles.RoleGrant.Roles)
+
+	// Without a role the operator is rejected everywhere
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: operator, Denom: token, Amount: math.NewInt(1), Recipient: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{Owner: operator, Denom: token, MaxSupply: math.NewInt(1000)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: operator, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Only the owner grants roles
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: operator, Denom: token, Address: operator, Role: types.ROLE_MINTER})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	allowance := math.NewInt(50)
+	_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: operator, Role: types.ROLE_MINTER, MintAllowance: &allowance})
+	require.NoError(t, err)
+	for _, role := range []types.Role{types.ROLE_BURNER, types.ROLE_METADATA_ADMIN, types.ROLE_PAUSER} {
+		_, err = srv.GrantRole(f.ctx, &types.MsgGrantRole{Creator: owner, Denom: token, Address: operator, Role: role})
+		require.NoError(t, err)
+		requireEvent(t, f.ctx, &types.EventRoleGranted{Denom: token, Address: operator, Role: role})
+	}
+
+	// The minter is capped by its allowance
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: operator, Denom: token, Amount: math.NewInt(30), Recipient: holder})
+	require.NoError(t, err)
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: operator, Denom: token, Amount: math.NewInt(21), Recipient: holder})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	operatorRoles, err := qs.AccountRoles(f.ctx, &types.QueryAccountRolesRequest{Denom: token, Address: operator})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(20), *operatorRoles.RoleGrant.MintAllowance)
+
+	// The other roles unlock their handlers
+	_, err = srv.ApproveBurn(f.ctx, &types.MsgApproveBurn{Holder: holder, Denom: token, Amount: math.NewInt(10)})
+	require.NoError(t, err)
+	_, err = srv.BurnFrom(f.ctx, &types.MsgBurnFrom{Creator: operator, Denom: token, Amount: math.NewInt(10), Holder: holder})
+	require.NoError(t, err)
+	_, err = srv.UpdateDenom(f.ctx, &types.MsgUpdateDenom{Owner: operator, Denom: token, Description: "updated", MaxSupply: math.NewInt(1000)})
+	require.NoError(t, err)
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: operator, Denom: token})
+	require.NoError(t, err)
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: operator, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UnpauseDenom(f.ctx, &types.MsgUnpauseDenom{Creator: owner, Denom: token})
+	require.NoError(t, err)
+
+	roles, err := qs.DenomRoles(f.ctx, &types.QueryDenomRolesRequest{Denom: token})
+	require.NoError(t, err)
+	require.Len(t, roles.RoleGrants, 2)
+
+	// Revoking removes the permission
+	_, err = srv.RevokeRole(f.ctx, &types.MsgRevokeRole{Creator: owner, Denom: token, Address: operator, Role: types.ROLE_PAUSER})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventRoleRevoked{Denom: token, Address: operator, Role: types.ROLE_PAUSER})
+	_, err = srv.PauseDenom(f.ctx, &types.MsgPauseDenom{Creator: operator, Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeRole(f.ctx, &types.MsgRevokeRole{Creator: owner, Denom: token, Address: operator, Role: types.ROLE_PAUSER})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Transferring ownership hands the owner roles over once accepted
+	_, err = srv.UpdateOwner(f.ctx, &types.MsgUpdateOwner{Creator: owner, Denom: token, NewOwner: holder})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventOwnershipProposed{Denom: token, Owner: owner, ProposedOwner: holder})
+	_, err = srv.AcceptOwnership(f.ctx, &types.MsgAcceptOwnership{NewOwner: holder, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventOwnerChanged{Denom: token, PreviousOwner: owner, NewOwner: holder})
+	_, err = qs.AccountRoles(f.ctx, &types.QueryAccountRolesRequest{Denom: token, Address: owner})
+	require.Error(t, err)
+	holderRoles, err := qs.AccountRoles(f.ctx, &types.QueryAccountRolesRequest{Denom: token, Address: holder})
+	require.NoError(t, err)
+	require.ElementsMatch(t, types.AllRoles, holderRoles.RoleGrant.Roles)
+}
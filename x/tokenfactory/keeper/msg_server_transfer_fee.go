@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (k msgServer) SetTransferFee(ctx context.Context, msg *types.MsgSetTransferFee) (*types.MsgSetTransferFeeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	denom, err := k.Denom.Get(ctx, msg.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "denom not found")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.Creator != denom.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the owner can set the transfer fee")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.BasisPoints > params.MaxTransferFeeBasisPoints {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "transfer fee exceeds %d basis points", params.MaxTransferFeeBasisPoints)
+	}
+
+	// Zero basis points removes the fee
+	denom.TransferFee = nil
+	if msg.BasisPoints > 0 {
+		// Store canonical addresses so exemption checks against senders match
+		treasury, err := k.canonicalAddress(msg.Treasury)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid treasury address: %s", err))
+		}
+
+		var exempt []string
+		for _, address := range msg.ExemptAddresses {
+			address, err := k.canonicalAddress(address)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid exempt address: %s", err))
+			}
+			exempt = append(exempt, address)
+		}
+
+		denom.TransferFee = &types.TransferFee{BasisPoints: msg.BasisPoints, Treasury: treasury, ExemptAddresses: exempt}
+		if err := denom.TransferFee.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := params.ValidateTransferFee(*denom.TransferFee); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Denom.Set(ctx, msg.Denom, denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set transfer fee")
+	}
+
+	event := &types.EventTransferFeeSet{Denom: msg.Denom}
+	if denom.TransferFee != nil {
+		event.BasisPoints = denom.TransferFee.BasisPoints
+		event.Treasury = denom.TransferFee.Treasury
+		event.ExemptAddresses = denom.TransferFee.ExemptAddresses
+	}
+	if err := k.eventService.EventManager(ctx).Emit(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTransferFeeResponse{}, nil
+}
+
+// canonicalAddress re-encodes address with the address codec.
+func (k msgServer) canonicalAddress(address string) (string, error) {
+	bz, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return "", err
+	}
+
+	return k.addressCodec.BytesToString(bz)
+}
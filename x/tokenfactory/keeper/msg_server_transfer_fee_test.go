@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	module "nimo-chain/x/tokenfactory/module"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestTransferFeeMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.bankKeeper.restriction = f.keeper.SendRestrictionFn
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	aliceAddr := sdk.AccAddress("aliceAddr___________________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+	bobAddr := sdk.AccAddress("bobAddr_____________________")
+	bob, err := f.addressCodec.BytesToString(bobAddr)
+	require.NoError(t, err)
+	carolAddr := sdk.AccAddress("carolAddr___________________")
+	treasuryAddr := sdk.AccAddress("treasuryAddr________________")
+	treasury, err := f.addressCodec.BytesToString(treasuryAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(1_000), Recipient: alice})
+	require.NoError(t, err)
+
+	balance := func(addr sdk.AccAddress) math.Int {
+		return f.bankKeeper.GetBalance(f.ctx, addr, token).Amount
+	}
+	send := func(from, to sdk.AccAddress, amount int64) error {
+		return f.bankKeeper.SendCoins(f.ctx, from, to, sdk.NewCoins(sdk.NewInt64Coin(token, amount)))
+	}
+
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: alice, Denom: token, BasisPoints: 100, Treasury: treasury})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The fee is capped by the params
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token, BasisPoints: types.DefaultMaxTransferFeeBasisPoints + 1, Treasury: treasury})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// So is the number of exempt addresses
+	params := types.DefaultParams()
+	params.MaxTransferFeeExemptAddresses = 1
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token, BasisPoints: 100, Treasury: treasury, ExemptAddresses: []string{alice, bob}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token, BasisPoints: 100, Treasury: treasury})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventTransferFeeSet{Denom: token, BasisPoints: 100, Treasury: treasury})
+
+	// The sender pays 1% on top of the amount
+	require.NoError(t, send(aliceAddr, bobAddr, 500))
+	require.Equal(t, math.NewInt(495), balance(aliceAddr))
+	require.Equal(t, math.NewInt(500), balance(bobAddr))
+	require.Equal(t, math.NewInt(5), balance(treasuryAddr))
+
+	// Fees round down
+	require.NoError(t, send(bobAddr, aliceAddr, 50))
+	require.Equal(t, math.NewInt(450), balance(bobAddr))
+
+	// Transfers from the treasury are not charged
+	require.NoError(t, send(treasuryAddr, bobAddr, 5))
+	require.True(t, balance(treasuryAddr).IsZero())
+
+	// Module accounts are exempt
+	moduleAcc := authtypes.NewEmptyModuleAccount("escrowmodule")
+	f.authKeeper.SetAccount(f.ctx, moduleAcc)
+	require.NoError(t, send(bobAddr, moduleAcc.GetAddress(), 100))
+	require.NoError(t, send(moduleAcc.GetAddress(), bobAddr, 100))
+	require.Equal(t, math.NewInt(455), balance(bobAddr))
+
+	// So are the escrow accounts of ICS-20 channels, recorded when they open
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+	transferStack := module.NewIBCMiddleware(f.keeper, mockTransferModule{})
+	_, err = transferStack.OnChanOpenInit(sdkCtx, channeltypes.UNORDERED, nil, ibctransfertypes.PortID, "channel-0", channeltypes.Counterparty{}, ibctransfertypes.V1)
+	require.NoError(t, err)
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	require.NoError(t, send(bobAddr, escrowAddr, 100))
+	require.NoError(t, send(escrowAddr, bobAddr, 100))
+	require.Equal(t, math.NewInt(455), balance(bobAddr))
+	require.True(t, balance(treasuryAddr).IsZero())
+
+	// And the client escrow accounts of IBC v2, recorded before the first
+	// packet escrows tokens
+	transferStackV2 := module.NewIBCMiddlewareV2(f.keeper, mockTransferModuleV2{
+		onSend: func(ctx sdk.Context, sourceClient string, payload channeltypesv2.Payload, signer sdk.AccAddress) error {
+			return send(signer, ibctransfertypes.GetEscrowAddress(payload.SourcePort, sourceClient), 100)
+		},
+	})
+	payload := channeltypesv2.Payload{SourcePort: ibctransfertypes.PortID, DestinationPort: ibctransfertypes.PortID}
+	require.NoError(t, transferStackV2.OnSendPacket(sdkCtx, "07-tendermint-0", "07-tendermint-1", 1, payload, bobAddr))
+	require.NoError(t, send(ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "07-tendermint-0"), bobAddr, 100))
+	require.Equal(t, math.NewInt(455), balance(bobAddr))
+	require.True(t, balance(treasuryAddr).IsZero())
+
+	// Exempt addresses
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token, BasisPoints: 100, Treasury: treasury, ExemptAddresses: []string{bob}})
+	require.NoError(t, err)
+	require.NoError(t, send(bobAddr, aliceAddr, 100))
+	require.True(t, balance(treasuryAddr).IsZero())
+
+	// A lowered cap applies to fees set above it
+	params.MaxTransferFeeBasisPoints = 50
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, send(aliceAddr, carolAddr, 200))
+	require.Equal(t, math.NewInt(1), balance(treasuryAddr))
+
+	// A sender that cannot cover the fee cannot transfer
+	require.Error(t, send(aliceAddr, carolAddr, balance(aliceAddr).Int64()))
+
+	// Zero basis points removes the fee
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token})
+	require.NoError(t, err)
+	requireEvent(t, f.ctx, &types.EventTransferFeeSet{Denom: token})
+
+	denom, err := f.keeper.Denom.Get(f.ctx, token)
+	require.NoError(t, err)
+	require.Nil(t, denom.TransferFee)
+
+	require.NoError(t, send(aliceAddr, carolAddr, balance(aliceAddr).Int64()))
+}
+
+func TestTransferFeeSkipsRestriction(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	f.bankKeeper.restriction = f.keeper.SendRestrictionFn
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	aliceAddr := sdk.AccAddress("aliceAddr___________________")
+	alice, err := f.addressCodec.BytesToString(aliceAddr)
+	require.NoError(t, err)
+	bobAddr := sdk.AccAddress("bobAddr_____________________")
+	treasuryAddr := sdk.AccAddress("treasuryAddr________________")
+	treasury, err := f.addressCodec.BytesToString(treasuryAddr)
+	require.NoError(t, err)
+	hookAddr := sdk.AccAddress("hookContract________________")
+	hook, err := f.addressCodec.BytesToString(hookAddr)
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(10_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MintAndSendTokens(f.ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(1_000), Recipient: alice})
+	require.NoError(t, err)
+	_, err = srv.SetTransferFee(f.ctx, &types.MsgSetTransferFee{Creator: owner, Denom: token, BasisPoints: 100, Treasury: treasury})
+	require.NoError(t, err)
+
+	f.wasmKeeper.codeIDs[string(hookAddr)] = 7
+	params := types.DefaultParams()
+	params.AllowedHookCodeIds = []uint64{7}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = srv.SetBeforeSendHook(f.ctx, &types.MsgSetBeforeSendHook{Creator: owner, Denom: token, ContractAddress: hook})
+	require.NoError(t, err)
+
+	// The fee moves without running the hook or the freeze check again, so a
+	// frozen treasury does not block transfers
+	require.NoError(t, f.keeper.FrozenAccount.Set(f.ctx, collections.Join(token, treasury)))
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, aliceAddr, bobAddr, sdk.NewCoins(sdk.NewInt64Coin(token, 500))))
+	require.Len(t, f.wasmKeeper.sudoMsgs, 1)
+	require.Equal(t, math.NewInt(5), f.bankKeeper.GetBalance(f.ctx, treasuryAddr, token).Amount)
+	require.Equal(t, math.NewInt(495), f.bankKeeper.GetBalance(f.ctx, aliceAddr, token).Amount)
+}
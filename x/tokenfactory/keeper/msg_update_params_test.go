@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"nimo-chain/x/tokenfactory/keeper"
@@ -36,12 +38,39 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "blank mint epoch identifier",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "mint epoch identifier cannot be blank",
+		},
+		{
+			name: "invalid denom creation fee",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: types.Params{
+					MintEpochIdentifier:  params.MintEpochIdentifier,
+					DenomCreationFee:     sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)}},
+					MaxDescriptionLength: params.MaxDescriptionLength,
+					MaxUrlLength:         params.MaxUrlLength,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid denom creation fee",
+		},
+		{
+			name: "zero max description length",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: types.Params{
+					MintEpochIdentifier: params.MintEpochIdentifier,
+					MaxUrlLength:        params.MaxUrlLength,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "max description length must be positive",
 		},
 		{
 			name: "all good",
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// transferOwnership makes newOwner the owner of denom, hands the roles of the
+// previous owner over and drops any pending ownership proposal.
+func (k Keeper) transferOwnership(ctx context.Context, denom types.Denom, newOwner string) error {
+	previousOwner := denom.Owner
+
+	denom.Owner = newOwner
+	if err := k.Denom.Set(ctx, denom.Denom, denom); err != nil {
+		return err
+	}
+
+	if err := k.RoleGrant.Remove(ctx, collections.Join(denom.Denom, previousOwner)); err != nil {
+		return err
+	}
+	if err := k.grantAllRoles(ctx, denom.Denom, newOwner); err != nil {
+		return err
+	}
+
+	if err := k.removeOwnershipProposal(ctx, denom.Denom); err != nil {
+		return err
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.EventOwnerChanged{
+		Denom:         denom.Denom,
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+	})
+}
+
+// setOwnershipProposal stores proposal, replacing any pending proposal for the
+// same denom, and queues it for pruning when it has an expiry.
+func (k Keeper) setOwnershipProposal(ctx context.Context, proposal types.OwnershipProposal) error {
+	if err := k.removeOwnershipProposal(ctx, proposal.Denom); err != nil {
+		return err
+	}
+
+	if err := k.OwnershipProposal.Set(ctx, proposal.Denom, proposal); err != nil {
+		return err
+	}
+
+	if proposal.Expiry == nil {
+		return nil
+	}
+
+	return k.OwnershipExpiryQueue.Set(ctx, collections.Join(*proposal.Expiry, proposal.Denom))
+}
+
+// removeOwnershipProposal drops the pending proposal of denom, if any, along
+// with its expiry queue entry.
+func (k Keeper) removeOwnershipProposal(ctx context.Context, denom string) error {
+	proposal, err := k.OwnershipProposal.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if proposal.Expiry != nil {
+		if err := k.OwnershipExpiryQueue.Remove(ctx, collections.Join(*proposal.Expiry, denom)); err != nil {
+			return err
+		}
+	}
+
+	return k.OwnershipProposal.Remove(ctx, denom)
+}
+
+// PruneExpiredOwnershipProposals removes every ownership proposal whose expiry
+// is not after the current block time.
+func (k Keeper) PruneExpiredOwnershipProposals(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	// Collect first so the queue is not written while it is being iterated
+	var expired []string
+	err := k.OwnershipExpiryQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, string](blockTime), func(key collections.Pair[time.Time, string]) (stop bool, err error) {
+		expired = append(expired, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range expired {
+		if err := k.removeOwnershipProposal(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
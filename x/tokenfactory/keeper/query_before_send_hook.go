@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (q queryServer) BeforeSendHook(ctx context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, err := q.k.BeforeSendHook.Get(ctx, req.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryBeforeSendHookResponse{ContractAddress: contract}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -22,8 +23,8 @@ func createNDenom(keeper keeper.Keeper, ctx context.Context, n int) []types.Deno
 		items[i].Ticker = strconv.Itoa(i)
 		items[i].Precision = int64(i)
 		items[i].Url = strconv.Itoa(i)
-		items[i].MaxSupply = int64(i)
-		items[i].Supply = int64(i)
+		items[i].MaxSupply = math.NewInt(int64(i))
+		items[i].Supply = math.NewInt(int64(i))
 		items[i].CanChangeMaxSupply = true
 		_ = keeper.Denom.Set(ctx, items[i].Denom, items[i])
 	}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// DenomsByOwner pages through the owner index. The index is a multi index
+// without raw iteration, so paging is done here instead of through
+// query.CollectionPaginate; the page key is the next denom of the owner.
+func (q queryServer) DenomsByOwner(ctx context.Context, req *types.QueryDenomsByOwnerRequest) (*types.QueryDenomsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.addressCodec.StringToBytes(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	rng := collections.NewPrefixedPairRange[string, string](req.Owner)
+	if len(pageReq.Key) > 0 {
+		if pageReq.Reverse {
+			rng = rng.EndInclusive(string(pageReq.Key))
+		} else {
+			rng = rng.StartInclusive(string(pageReq.Key))
+		}
+	}
+	if pageReq.Reverse {
+		rng = rng.Descending()
+	}
+
+	iter, err := q.k.Denom.Indexes.Owner.Iterate(ctx, rng)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var (
+		denoms  []types.Denom
+		pageRes = &query.PageResponse{}
+		total   uint64
+	)
+	for ; iter.Valid(); iter.Next() {
+		total++
+		if total <= pageReq.Offset {
+			continue
+		}
+
+		denomName, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if uint64(len(denoms)) == limit {
+			if pageRes.NextKey == nil {
+				pageRes.NextKey = []byte(denomName)
+			}
+			if !pageReq.CountTotal {
+				break
+			}
+			continue
+		}
+
+		denom, err := q.k.Denom.Get(ctx, denomName)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		denoms = append(denoms, denom)
+	}
+
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return &types.QueryDenomsByOwnerResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestDenomsByOwnerQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	var owned []string
+	for i := 0; i < 5; i++ {
+		resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token" + strconv.Itoa(i), MaxSupply: math.NewInt(100)})
+		require.NoError(t, err)
+		owned = append(owned, resp.NewTokenDenom)
+	}
+	_, err = srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: other, Subdenom: "token", MaxSupply: math.NewInt(100)})
+	require.NoError(t, err)
+
+	list := func(owner string, pagination *query.PageRequest) ([]string, *query.PageResponse) {
+		resp, err := qs.DenomsByOwner(f.ctx, &types.QueryDenomsByOwnerRequest{Owner: owner, Pagination: pagination})
+		require.NoError(t, err)
+		var denoms []string
+		for _, denom := range resp.Denoms {
+			require.Equal(t, owner, denom.Owner)
+			denoms = append(denoms, denom.Denom)
+		}
+		return denoms, resp.Pagination
+	}
+
+	t.Run("ByKey", func(t *testing.T) {
+		var (
+			all  []string
+			next []byte
+		)
+		for {
+			denoms, pageRes := list(owner, &query.PageRequest{Key: next, Limit: 2})
+			require.LessOrEqual(t, len(denoms), 2)
+			all = append(all, denoms...)
+			if next = pageRes.NextKey; next == nil {
+				break
+			}
+		}
+		require.Equal(t, owned, all)
+	})
+	t.Run("ByOffset", func(t *testing.T) {
+		denoms, pageRes := list(owner, &query.PageRequest{Offset: 3, Limit: 1, CountTotal: true})
+		require.Equal(t, owned[3:4], denoms)
+		require.Equal(t, uint64(len(owned)), pageRes.Total)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		denoms, _ := list(owner, &query.PageRequest{Limit: 2, Reverse: true})
+		require.Equal(t, []string{owned[4], owned[3]}, denoms)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.DenomsByOwner(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = qs.DenomsByOwner(f.ctx, &types.QueryDenomsByOwnerRequest{Owner: "invalid"})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid owner address"))
+	})
+
+	// The index follows ownership changes and deletions
+	_, err = srv.ProposeOwner(f.ctx, &types.MsgProposeOwner{Creator: owner, Denom: owned[0], NewOwner: other})
+	require.NoError(t, err)
+	_, err = srv.AcceptOwnership(f.ctx, &types.MsgAcceptOwnership{NewOwner: other, Denom: owned[0]})
+	require.NoError(t, err)
+	_, err = srv.DeleteDenom(f.ctx, &types.MsgDeleteDenom{Creator: owner, Denom: owned[1]})
+	require.NoError(t, err)
+
+	denoms, _ := list(owner, nil)
+	require.Equal(t, owned[2:], denoms)
+	denoms, _ = list(other, nil)
+	require.ElementsMatch(t, []string{owned[0], "factory/" + other + "/token"}, denoms)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (q queryServer) Distribution(ctx context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	distribution, err := q.k.Distribution.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryDistributionResponse{Distribution: distribution}, nil
+}
+
+func (q queryServer) DistributionClaimable(ctx context.Context, req *types.QueryDistributionClaimableRequest) (*types.QueryDistributionClaimableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	distribution, err := q.k.Distribution.Get(ctx, req.DistributionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	claimed, err := q.k.DistributionClaim.Has(ctx, collections.Join(req.DistributionId, req.Address))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if claimed || !sdk.UnwrapSDKContext(ctx).BlockTime().Before(distribution.Expiry) {
+		return &types.QueryDistributionClaimableResponse{Amount: sdk.NewCoins(), Claimed: claimed}, nil
+	}
+
+	share, err := q.k.distributionShare(ctx, distribution, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryDistributionClaimableResponse{Amount: share}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (q queryServer) FeeDenoms(ctx context.Context, req *types.QueryFeeDenomsRequest) (*types.QueryFeeDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	feeDenoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.FeeDenom,
+		req.Pagination,
+		func(_ string, value types.FeeDenom) (types.FeeDenom, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeDenomsResponse{FeeDenoms: feeDenoms, Pagination: pageRes}, nil
+}
+
+func (q queryServer) FeeDenomRate(ctx context.Context, req *types.QueryFeeDenomRateRequest) (*types.QueryFeeDenomRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rate, err := q.k.FeeDenomRate(ctx, req.Denom)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrNotFeeDenom):
+			return nil, status.Error(codes.NotFound, "not found")
+		case errors.Is(err, types.ErrStaleFeeRate):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryFeeDenomRateResponse{Rate: rate}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (q queryServer) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.FrozenAccount,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (string, error) {
+			return key.K2(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (q queryServer) IsFrozen(ctx context.Context, req *types.QueryIsFrozenRequest) (*types.QueryIsFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	frozen, err := q.k.isFrozen(ctx, req.Denom, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryIsFrozenResponse{Frozen: frozen}, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (q queryServer) DenomRoles(ctx context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RoleGrant,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value types.RoleGrant) (types.RoleGrant, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomRolesResponse{RoleGrants: grants, Pagination: pageRes}, nil
+}
+
+func (q queryServer) AccountRoles(ctx context.Context, req *types.QueryAccountRolesRequest) (*types.QueryAccountRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grant, err := q.k.RoleGrant.Get(ctx, collections.Join(req.Denom, req.Address))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAccountRolesResponse{RoleGrant: grant}, nil
+}
+
+func (q queryServer) MinterAllowance(ctx context.Context, req *types.QueryMinterAllowanceRequest) (*types.QueryMinterAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grant, err := q.k.RoleGrant.Get(ctx, collections.Join(req.Denom, req.Minter))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !grant.HasRole(types.ROLE_MINTER) {
+		return nil, status.Error(codes.NotFound, "not a minter")
+	}
+
+	minted, err := q.k.epochMinted(ctx, req.Denom, req.Minter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	res := &types.QueryMinterAllowanceResponse{RemainingTotal: grant.MintAllowance, MintedInEpoch: minted}
+	if grant.PerEpochLimit != nil {
+		remaining := math.ZeroInt()
+		if grant.PerEpochLimit.GT(minted) {
+			remaining = grant.PerEpochLimit.Sub(minted)
+		}
+		res.RemainingInEpoch = &remaining
+	}
+
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func (q queryServer) SupplyAt(ctx context.Context, req *types.QuerySupplyAtRequest) (*types.QuerySupplyAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	height, supply, err := q.k.supplyAt(ctx, req.Denom, req.Height)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "no supply checkpoint at or before height")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QuerySupplyAtResponse{Supply: supply, CheckpointHeight: height}, nil
+}
+
+func (q queryServer) HoldersSnapshot(ctx context.Context, req *types.QueryHoldersSnapshotRequest) (*types.QueryHoldersSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := q.k.Denom.Get(ctx, req.Denom); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	owners, err := q.k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{Denom: req.Denom, Pagination: req.Pagination})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	holders := make([]types.Holder, 0, len(owners.DenomOwners))
+	for _, owner := range owners.DenomOwners {
+		holders = append(holders, types.Holder{Address: owner.Address, Amount: owner.Balance.Amount})
+	}
+
+	return &types.QueryHoldersSnapshotResponse{
+		Height:     sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Holders:    holders,
+		Pagination: owners.Pagination,
+	}, nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"nimo-chain/x/tokenfactory/keeper"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestSupplyAtQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	epoch := params.SupplyCheckpointEpochIdentifier
+
+	mintAndCheckpoint := func(height int64, amount int64) {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(height)
+		if amount > 0 {
+			_, err := srv.MintAndSendTokens(ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(amount), Recipient: owner})
+			require.NoError(t, err)
+		}
+		require.NoError(t, f.keeper.Hooks().AfterEpochEnd(ctx, epoch, height))
+	}
+
+	mintAndCheckpoint(10, 100)
+	mintAndCheckpoint(20, 0)
+	mintAndCheckpoint(30, 50)
+
+	// Other epochs do not record checkpoints
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(40)
+	_, err = srv.MintAndSendTokens(ctx, &types.MsgMintAndSendTokens{Creator: owner, Denom: token, Amount: math.NewInt(1), Recipient: owner})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Hooks().AfterEpochEnd(ctx, "other", 1))
+
+	// An unchanged supply does not add a checkpoint
+	has, err := f.keeper.SupplyCheckpoint.Has(f.ctx, collections.Join(token, int64(20)))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	for _, tc := range []struct {
+		height     int64
+		supply     int64
+		checkpoint int64
+	}{
+		{height: 10, supply: 100, checkpoint: 10},
+		{height: 25, supply: 100, checkpoint: 10},
+		{height: 30, supply: 150, checkpoint: 30},
+		{height: 45, supply: 150, checkpoint: 30},
+	} {
+		res, err := qs.SupplyAt(f.ctx, &types.QuerySupplyAtRequest{Denom: token, Height: tc.height})
+		require.NoError(t, err)
+		require.Equal(t, math.NewInt(tc.supply), res.Supply)
+		require.Equal(t, tc.checkpoint, res.CheckpointHeight)
+	}
+
+	_, err = qs.SupplyAt(f.ctx, &types.QuerySupplyAtRequest{Denom: token, Height: 9})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.SupplyAt(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestHoldersSnapshotQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	alice := sdk.AccAddress("aliceAddr___________________").String()
+	bob := sdk.AccAddress("bobAddr_____________________").String()
+
+	resp, err := srv.CreateDenom(f.ctx, &types.MsgCreateDenom{Owner: owner, Subdenom: "token", MaxSupply: math.NewInt(1_000)})
+	require.NoError(t, err)
+	token := resp.NewTokenDenom
+
+	_, err = srv.MultiMint(f.ctx, &types.MsgMultiMint{Creator: owner, Denom: token, Outputs: []types.MintOutput{
+		{Recipient: alice, Amount: math.NewInt(10)},
+		{Recipient: bob, Amount: math.NewInt(20)},
+	}})
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(7)
+	res, err := qs.HoldersSnapshot(ctx, &types.QueryHoldersSnapshotRequest{Denom: token})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), res.Height)
+	require.ElementsMatch(t, []types.Holder{
+		{Address: alice, Amount: math.NewInt(10)},
+		{Address: bob, Amount: math.NewInt(20)},
+	}, res.Holders)
+
+	_, err = qs.HoldersSnapshot(ctx, &types.QueryHoldersSnapshotRequest{Denom: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
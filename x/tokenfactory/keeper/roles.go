@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// requireRole returns the role grant of address for denom, or ErrUnauthorized
+// if the address does not hold role.
+func (k Keeper) requireRole(ctx context.Context, denom, address string, role types.Role) (types.RoleGrant, error) {
+	grant, err := k.RoleGrant.Get(ctx, collections.Join(denom, address))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return grant, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if !grant.HasRole(role) {
+		return grant, errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s does not hold %s for %s", address, role, denom))
+	}
+
+	return grant, nil
+}
+
+// grantAllRoles gives address every role for denom with unlimited minting.
+func (k Keeper) grantAllRoles(ctx context.Context, denom, address string) error {
+	grant := types.RoleGrant{
+		Denom:   denom,
+		Address: address,
+		Roles:   append([]types.Role(nil), types.AllRoles...),
+	}
+
+	return k.RoleGrant.Set(ctx, collections.Join(denom, address), grant)
+}
+
+// chargeMintAllowance lowers the mint allowance of a minter grant by amount
+// and adds it to the minter's count for the current mint epoch. Unset limits
+// do not restrict minting.
+func (k Keeper) chargeMintAllowance(ctx context.Context, grant types.RoleGrant, amount math.Int) error {
+	key := collections.Join(grant.Denom, grant.Address)
+
+	if grant.MintAllowance != nil && amount.GT(*grant.MintAllowance) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mint amount exceeds minter allowance")
+	}
+
+	if grant.PerEpochLimit != nil {
+		minted, err := k.epochMinted(ctx, grant.Denom, grant.Address)
+		if err != nil {
+			return err
+		}
+
+		minted = minted.Add(amount)
+		if minted.GT(*grant.PerEpochLimit) {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "mint amount exceeds per-epoch mint limit")
+		}
+
+		if err := k.EpochMint.Set(ctx, key, minted); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update epoch mint")
+		}
+	}
+
+	if grant.MintAllowance != nil {
+		remaining := grant.MintAllowance.Sub(amount)
+		grant.MintAllowance = &remaining
+		if err := k.RoleGrant.Set(ctx, key, grant); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update mint allowance")
+		}
+	}
+
+	return nil
+}
+
+// epochMinted returns how much minter has minted of denom in the current mint
+// epoch.
+func (k Keeper) epochMinted(ctx context.Context, denom, minter string) (math.Int, error) {
+	minted, err := k.EpochMint.Get(ctx, collections.Join(denom, minter))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.ZeroInt(), nil
+		}
+		return math.Int{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return minted, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// SendRestrictionFn is registered with x/bank and runs on every transfer, so
+// it also covers IBC escrow, ERC-20 conversions and wasm bank messages. It
+// rejects transfers of a paused factory denom and transfers from or to a
+// frozen account, then lets the before-send hook of the denom veto the
+// transfer and charges the transfer fee of the denom. Force transfers skip all
+// of it. Every transfer first records the balances of both parties for the
+// active distributions of the denom. Transfer fees skip the whole restriction,
+// since the transfer charging them already passed it.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if isTransferFee(ctx) {
+		return toAddr, nil
+	}
+
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if err := k.recordDistributionBalances(ctx, coin.Denom, fromAddr, toAddr); err != nil {
+			return toAddr, err
+		}
+		if isForceTransfer(ctx) {
+			continue
+		}
+
+		denom, err := k.Denom.Get(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return toAddr, err
+		}
+		if denom.Paused {
+			return toAddr, errorsmod.Wrapf(types.ErrDenomPaused, "denom %s is paused", coin.Denom)
+		}
+
+		for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+			frozen, err := k.isFrozen(ctx, coin.Denom, addr)
+			if err != nil {
+				return toAddr, err
+			}
+			if frozen {
+				return toAddr, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account %s is frozen for denom %s", addr, coin.Denom)
+			}
+		}
+
+		if err := k.callBeforeSendHook(ctx, fromAddr, toAddr, coin); err != nil {
+			return toAddr, err
+		}
+
+		if err := k.chargeTransferFee(ctx, denom, fromAddr, toAddr, coin); err != nil {
+			return toAddr, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// isFrozen reports whether addr is in the FrozenAccount set of denom.
+func (k Keeper) isFrozen(ctx context.Context, denom string, addr sdk.AccAddress) (bool, error) {
+	address, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+
+	return k.FrozenAccount.Has(ctx, collections.Join(denom, address))
+}
+
+// isModuleAccount reports whether addr belongs to a module account.
+func (k Keeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.authKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// forceTransferKey marks a context as running a force transfer.
+type forceTransferKey struct{}
+
+// withForceTransfer returns a context whose transfers skip SendRestrictionFn.
+func withForceTransfer(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(forceTransferKey{}, true)
+}
+
+// isForceTransfer reports whether ctx was returned by withForceTransfer.
+func isForceTransfer(ctx context.Context) bool {
+	forced, _ := ctx.Value(forceTransferKey{}).(bool)
+	return forced
+}
+
+// transferFeeKey marks a context as paying a transfer fee.
+type transferFeeKey struct{}
+
+// withTransferFee returns a context whose transfers skip SendRestrictionFn
+// entirely, including the recording of distribution balances.
+func withTransferFee(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(transferFeeKey{}, true)
+}
+
+// isTransferFee reports whether ctx was returned by withTransferFee.
+func isTransferFee(ctx context.Context) bool {
+	charged, _ := ctx.Value(transferFeeKey{}).(bool)
+	return charged
+}
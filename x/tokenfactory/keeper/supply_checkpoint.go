@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// checkpointSupplies records the bank supply of every denom at the current
+// height. A denom only gets a new checkpoint when its supply changed since its
+// latest one, so SupplyAt resolves a height to the latest checkpoint at or
+// before it.
+func (k Keeper) checkpointSupplies(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var denoms []string
+	if err := k.Denom.Walk(ctx, nil, func(denom string, _ types.Denom) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, denom := range denoms {
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+		_, latest, err := k.supplyAt(ctx, denom, height)
+		if err == nil && latest.Equal(supply) {
+			continue
+		}
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		if err := k.SupplyCheckpoint.Set(ctx, collections.Join(denom, height), supply); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// supplyAt returns the latest supply checkpoint of a denom at or before the
+// given height, along with the height it was recorded at.
+func (k Keeper) supplyAt(ctx context.Context, denom string, height int64) (int64, math.Int, error) {
+	rng := collections.NewPrefixedPairRange[string, int64](denom).EndInclusive(height).Descending()
+
+	iter, err := k.SupplyCheckpoint.Iterate(ctx, rng)
+	if err != nil {
+		return 0, math.Int{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, math.Int{}, collections.ErrNotFound
+	}
+
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return 0, math.Int{}, err
+	}
+
+	return kv.Key.K2(), kv.Value, nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// chargeTransferFee sends the transfer fee of coin from fromAddr to the
+// treasury of the denom. The fee is paid on top of the transferred amount, so
+// the recipient always gets the full amount. Transfers from or to the
+// treasury, an exempt address, a module account or an IBC escrow account are
+// not charged, which keeps minting, burning and bridging unaffected.
+func (k Keeper) chargeTransferFee(ctx context.Context, denom types.Denom, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) error {
+	if denom.TransferFee == nil {
+		return nil
+	}
+
+	for _, addr := range []sdk.AccAddress{fromAddr, toAddr} {
+		exempt, err := k.isTransferFeeExempt(ctx, *denom.TransferFee, addr)
+		if err != nil {
+			return err
+		}
+		if exempt {
+			return nil
+		}
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	fee := denom.TransferFee.Fee(coin.Amount, params.MaxTransferFeeBasisPoints)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	treasury, err := k.addressCodec.StringToBytes(denom.TransferFee.Treasury)
+	if err != nil {
+		return err
+	}
+
+	// The sender is already recorded and the transfer already passed the
+	// checks, so only the treasury is recorded before the fee moves
+	if err := k.recordDistributionBalances(ctx, coin.Denom, treasury); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(withTransferFee(ctx), fromAddr, treasury, sdk.NewCoins(sdk.NewCoin(coin.Denom, fee)))
+}
+
+// isTransferFeeExempt reports whether transfers from or to addr are not
+// charged the fee.
+func (k Keeper) isTransferFeeExempt(ctx context.Context, fee types.TransferFee, addr sdk.AccAddress) (bool, error) {
+	address, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+	if fee.IsExempt(address) {
+		return true, nil
+	}
+
+	if k.isModuleAccount(ctx, addr) {
+		return true, nil
+	}
+
+	return k.isEscrowAddress(ctx, addr)
+}
+
+// RecordEscrowAddress records the escrow account that ICS-20 uses for the
+// given port and channel, or client on IBC v2. The module's IBC middleware
+// calls it before the transfer stack moves tokens through the account.
+func (k Keeper) RecordEscrowAddress(ctx context.Context, portID, channelID string) error {
+	escrow := ibctransfertypes.GetEscrowAddress(portID, channelID)
+	has, err := k.EscrowAddress.Has(ctx, escrow)
+	if err != nil || has {
+		return err
+	}
+
+	return k.EscrowAddress.Set(ctx, escrow)
+}
+
+// isEscrowAddress reports whether addr escrows the tokens sent out over one
+// of the ICS-20 transfer channels or clients.
+func (k Keeper) isEscrowAddress(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.EscrowAddress.Has(ctx, addr)
+}
@@ -0,0 +1,204 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// denomV1 is the Denom record as stored in version 1, when the max supply
+// and supply were int64 varints. It is frozen here so that the conversion
+// does not depend on the current types.Denom schema.
+type denomV1 struct {
+	Denom              string
+	Description        string
+	Ticker             string
+	Precision          int64
+	Url                string
+	MaxSupply          int64
+	Supply             int64
+	CanChangeMaxSupply bool
+	Owner              string
+}
+
+// burnAllowanceV1 is the BurnAllowance record as stored in version 1.
+type burnAllowanceV1 struct {
+	Denom  string
+	Holder string
+	Amount int64
+}
+
+// MigrateStore rewrites the supply, max supply and burn allowance amounts
+// from int64 varints to math.Int. Keys are left untouched. Records that are
+// already in the math.Int encoding are skipped, so the conversion can run
+// again on a store it has converted before.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if err := migratePrefix(prefix.NewStore(store, types.DenomKey), func(bz []byte) ([]byte, error) {
+		legacy, ok, err := decodeDenomV1(bz)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		denom := types.Denom{
+			Denom:              legacy.Denom,
+			Description:        legacy.Description,
+			Ticker:             legacy.Ticker,
+			Precision:          legacy.Precision,
+			Url:                legacy.Url,
+			MaxSupply:          math.NewInt(legacy.MaxSupply),
+			Supply:             math.NewInt(legacy.Supply),
+			CanChangeMaxSupply: legacy.CanChangeMaxSupply,
+			Owner:              legacy.Owner,
+		}
+		return cdc.Marshal(&denom)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate denoms: %w", err)
+	}
+
+	if err := migratePrefix(prefix.NewStore(store, types.BurnAllowanceKey), func(bz []byte) ([]byte, error) {
+		legacy, ok, err := decodeBurnAllowanceV1(bz)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		allowance := types.BurnAllowance{
+			Denom:  legacy.Denom,
+			Holder: legacy.Holder,
+			Amount: math.NewInt(legacy.Amount),
+		}
+		return cdc.Marshal(&allowance)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate burn allowances: %w", err)
+	}
+
+	return nil
+}
+
+// migratePrefix applies convert to every value in store, leaving values for
+// which it returns nil unchanged. Writes are collected first so the store is
+// not mutated while it is being iterated.
+func migratePrefix(store prefix.Store, convert func([]byte) ([]byte, error)) error {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		bz, err := convert(iterator.Value())
+		if err != nil {
+			return fmt.Errorf("key %x: %w", iterator.Key(), err)
+		}
+		if bz == nil {
+			continue
+		}
+		keys = append(keys, iterator.Key())
+		values = append(values, bz)
+	}
+
+	for i := range keys {
+		store.Set(keys[i], values[i])
+	}
+
+	return nil
+}
+
+// decodeDenomV1 decodes a version 1 Denom record. It reports false when the
+// supplies are already length-delimited, which means the record was written
+// with the math.Int schema. Fields left out of the encoding, as proto3 does
+// for zero values, read as zero.
+func decodeDenomV1(bz []byte) (denomV1, bool, error) {
+	var denom denomV1
+	ok := true
+	err := consumeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			denom.Denom = string(value)
+		case num == 2 && typ == protowire.BytesType:
+			denom.Description = string(value)
+		case num == 3 && typ == protowire.BytesType:
+			denom.Ticker = string(value)
+		case num == 4 && typ == protowire.VarintType:
+			denom.Precision = int64(varint)
+		case num == 5 && typ == protowire.BytesType:
+			denom.Url = string(value)
+		case num == 6 && typ == protowire.VarintType:
+			denom.MaxSupply = int64(varint)
+		case num == 7 && typ == protowire.VarintType:
+			denom.Supply = int64(varint)
+		case num == 8 && typ == protowire.VarintType:
+			denom.CanChangeMaxSupply = varint != 0
+		case num == 9 && typ == protowire.BytesType:
+			denom.Owner = string(value)
+		case (num == 6 || num == 7) && typ == protowire.BytesType, num > 9:
+			ok = false
+		}
+	})
+	return denom, ok, err
+}
+
+// decodeBurnAllowanceV1 decodes a version 1 BurnAllowance record. It reports
+// false when the amount is already length-delimited.
+func decodeBurnAllowanceV1(bz []byte) (burnAllowanceV1, bool, error) {
+	var allowance burnAllowanceV1
+	ok := true
+	err := consumeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			allowance.Denom = string(value)
+		case num == 2 && typ == protowire.BytesType:
+			allowance.Holder = string(value)
+		case num == 3 && typ == protowire.VarintType:
+			allowance.Amount = int64(varint)
+		case num == 3 && typ == protowire.BytesType:
+			ok = false
+		}
+	})
+	return allowance, ok, err
+}
+
+// consumeFields calls fn for every field of a protobuf encoded message with
+// the field's bytes for length-delimited fields or its value for varints.
+func consumeFields(bz []byte, fn func(num protowire.Number, typ protowire.Type, value []byte, varint uint64)) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		switch typ {
+		case protowire.VarintType:
+			v, m := protowire.ConsumeVarint(bz)
+			if m < 0 {
+				return protowire.ParseError(m)
+			}
+			fn(num, typ, nil, v)
+			bz = bz[m:]
+		case protowire.BytesType:
+			v, m := protowire.ConsumeBytes(bz)
+			if m < 0 {
+				return protowire.ParseError(m)
+			}
+			fn(num, typ, v, 0)
+			bz = bz[m:]
+		default:
+			m := protowire.ConsumeFieldValue(num, typ, bz)
+			if m < 0 {
+				return protowire.ParseError(m)
+			}
+			fn(num, typ, nil, 0)
+			bz = bz[m:]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	v2 "nimo-chain/x/tokenfactory/migrations/v2"
+	module "nimo-chain/x/tokenfactory/module"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	store := ctx.KVStore(storeKey)
+
+	// Version 1 encodings: Denom{denom, max_supply, supply, owner} and
+	// BurnAllowance{denom, holder, amount}, with a zero supply left out.
+	var legacyDenom []byte
+	legacyDenom = protowire.AppendTag(legacyDenom, 1, protowire.BytesType)
+	legacyDenom = protowire.AppendString(legacyDenom, "factory/owner/token")
+	legacyDenom = protowire.AppendTag(legacyDenom, 6, protowire.VarintType)
+	legacyDenom = protowire.AppendVarint(legacyDenom, 1000)
+	legacyDenom = protowire.AppendTag(legacyDenom, 9, protowire.BytesType)
+	legacyDenom = protowire.AppendString(legacyDenom, "owner")
+
+	var legacyAllowance []byte
+	legacyAllowance = protowire.AppendTag(legacyAllowance, 1, protowire.BytesType)
+	legacyAllowance = protowire.AppendString(legacyAllowance, "factory/owner/token")
+	legacyAllowance = protowire.AppendTag(legacyAllowance, 2, protowire.BytesType)
+	legacyAllowance = protowire.AppendString(legacyAllowance, "holder")
+	legacyAllowance = protowire.AppendTag(legacyAllowance, 3, protowire.VarintType)
+	legacyAllowance = protowire.AppendVarint(legacyAllowance, 25)
+
+	denomKey := append(types.DenomKey.Bytes(), []byte("factory/owner/token")...)
+	allowanceKey := append(types.BurnAllowanceKey.Bytes(), []byte("allowance")...)
+	store.Set(denomKey, legacyDenom)
+	store.Set(allowanceKey, legacyAllowance)
+
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+
+	var denom types.Denom
+	require.NoError(t, cdc.Unmarshal(store.Get(denomKey), &denom))
+	require.Equal(t, "factory/owner/token", denom.Denom)
+	require.Equal(t, "owner", denom.Owner)
+	require.Equal(t, math.NewInt(1000), denom.MaxSupply)
+	require.Equal(t, math.ZeroInt(), denom.Supply)
+
+	var allowance types.BurnAllowance
+	require.NoError(t, cdc.Unmarshal(store.Get(allowanceKey), &allowance))
+	require.Equal(t, "holder", allowance.Holder)
+	require.Equal(t, math.NewInt(25), allowance.Amount)
+
+	// Running again leaves converted records untouched
+	converted := store.Get(denomKey)
+	require.NoError(t, v2.MigrateStore(ctx, runtime.NewKVStoreService(storeKey), cdc))
+	require.Equal(t, converted, store.Get(denomKey))
+}
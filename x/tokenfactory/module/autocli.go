@@ -41,6 +41,95 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-denom"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
 				},
+				{
+					RpcMethod:      "FrozenAccounts",
+					Use:            "frozen-accounts [denom]",
+					Short:          "List the frozen accounts of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "IsFrozen",
+					Use:            "is-frozen [denom] [address]",
+					Short:          "Check whether an account is frozen for a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "IsPaused",
+					Use:            "is-paused [denom]",
+					Short:          "Check whether a denom is paused",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomRoles",
+					Use:            "denom-roles [denom]",
+					Short:          "List the role grants of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "AccountRoles",
+					Use:            "account-roles [denom] [address]",
+					Short:          "Show the roles an address holds for a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "MinterAllowance",
+					Use:            "minter-allowance [denom] [minter]",
+					Short:          "Show the remaining mint allowance of a minter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "minter"}},
+				},
+				{
+					RpcMethod:      "OwnershipProposal",
+					Use:            "ownership-proposal [denom]",
+					Short:          "Show the pending ownership proposal of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsByOwner",
+					Use:            "denoms-by-owner [owner]",
+					Short:          "List the denoms owned by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "BeforeSendHook",
+					Use:            "before-send-hook [denom]",
+					Short:          "Show the before-send hook contract of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "SupplyAt",
+					Use:            "supply-at [denom] [height]",
+					Short:          "Show the supply of a denom at a past height from the supply checkpoints",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "HoldersSnapshot",
+					Use:            "holders-snapshot [denom]",
+					Short:          "List the holders of a denom at the current height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "FeeDenoms",
+					Use:       "fee-denoms",
+					Short:     "List the denoms accepted for transaction fees",
+				},
+				{
+					RpcMethod:      "FeeDenomRate",
+					Use:            "fee-denom-rate [denom]",
+					Short:          "Show the current conversion rate of a fee denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "Distribution",
+					Use:            "distribution [id]",
+					Short:          "Show a distribution to the holders of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "DistributionClaimable",
+					Use:            "distribution-claimable [distribution-id] [address]",
+					Short:          "Show the rewards an address can claim from a distribution",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "distribution_id"}, {ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -54,9 +143,9 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod:      "CreateDenom",
-					Use:            "create-denom [denom] [description] [ticker] [precision] [url] [max-supply] [can-change-max-supply]",
-					Short:          "Create a new Denom",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "description"}, {ProtoField: "ticker"}, {ProtoField: "precision"}, {ProtoField: "url"}, {ProtoField: "maxSupply"}, {ProtoField: "canChangeMaxSupply"}},
+					Use:            "create-denom [subdenom] [description] [ticker] [precision] [url] [max-supply] [can-change-max-supply]",
+					Short:          "Create a new Denom namespaced as factory/{owner}/{subdenom}",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}, {ProtoField: "description"}, {ProtoField: "ticker"}, {ProtoField: "precision"}, {ProtoField: "url"}, {ProtoField: "maxSupply"}, {ProtoField: "canChangeMaxSupply"}},
 				},
 				{
 					RpcMethod:      "UpdateDenom",
@@ -82,6 +171,152 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 			Short: "Send a UpdateOwner tx",
 			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_owner"}},
 		},
+		{
+			RpcMethod: "DeleteDenom",
+			Use: "delete-denom [denom]",
+			Short: "Delete a Denom without supply",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+		},
+		{
+			RpcMethod: "Burn",
+			Use: "burn [denom] [amount]",
+			Short: "Burn tokens from your own balance",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "amount"}},
+		},
+		{
+			RpcMethod: "BurnFrom",
+			Use: "burn-from [denom] [amount] [holder]",
+			Short: "Burn tokens from a holder that approved the burn",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "amount"}, {ProtoField: "holder"}},
+		},
+		{
+			RpcMethod: "ApproveBurn",
+			Use: "approve-burn [denom] [amount]",
+			Short: "Allow the denom owner to burn up to amount tokens from your balance",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "amount"}},
+		},
+		{
+			RpcMethod: "FreezeAccount",
+			Use: "freeze-account [denom] [address]",
+			Short: "Prevent an account from sending or receiving a denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+		},
+		{
+			RpcMethod: "UnfreezeAccount",
+			Use: "unfreeze-account [denom] [address]",
+			Short: "Lift the freeze of an account for a denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+		},
+		{
+			RpcMethod: "PauseDenom",
+			Use: "pause-denom [denom]",
+			Short: "Halt every transfer, mint and burn of a denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+		},
+		{
+			RpcMethod: "UnpauseDenom",
+			Use: "unpause-denom [denom]",
+			Short: "Resume a paused denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+		},
+		{
+			RpcMethod: "GrantRole",
+			Use: "grant-role [denom] [address] [role]",
+			Short: "Grant a minter, burner, metadata admin or pauser role; --mint-allowance caps a minter",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}, {ProtoField: "role"}},
+		},
+		{
+			RpcMethod: "RevokeRole",
+			Use: "revoke-role [denom] [address] [role]",
+			Short: "Revoke a role from an address",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}, {ProtoField: "role"}},
+		},
+		{
+			RpcMethod: "SetMinterAllowance",
+			Use: "set-minter-allowance [denom] [minter]",
+			Short: "Cap a minter with --total-allowance and --per-epoch-limit; omitted limits are removed",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "minter"}},
+		},
+		{
+			RpcMethod: "ProposeOwner",
+			Use: "propose-owner [denom] [new-owner]",
+			Short: "Propose a new owner who must accept before ownership moves",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_owner"}},
+		},
+		{
+			RpcMethod: "AcceptOwnership",
+			Use: "accept-ownership [denom]",
+			Short: "Accept a pending ownership proposal",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+		},
+		{
+			RpcMethod: "CancelOwnershipProposal",
+			Use: "cancel-ownership-proposal [denom]",
+			Short: "Cancel a pending ownership proposal",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+		},
+		{
+			RpcMethod: "RenounceOwnership",
+			Use: "renounce-ownership [denom]",
+			Short: "Give up ownership, making supply and metadata immutable",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+		},
+		{
+			RpcMethod: "SetBeforeSendHook",
+			Use: "set-before-send-hook [denom] [contract-address]",
+			Short: "Call a wasm contract before every transfer of a denom; an empty address removes the hook",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "contract_address"}},
+		},
+		{
+			RpcMethod: "MultiMint",
+			Use: "multi-mint [denom] [output]...",
+			Short: "Mint to many recipients at once; each output is a JSON {\"recipient\", \"amount\"} object",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "outputs", Varargs: true}},
+		},
+		{
+			RpcMethod: "MintVested",
+			Use: "mint-vested [denom] [recipient] [amount]",
+			Short: "Mint into a new vesting account; set --end-time for continuous vesting or --periods for periodic vesting",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "recipient"}, {ProtoField: "amount"}},
+		},
+		{
+			RpcMethod: "ForceTransfer",
+			Use: "force-transfer [denom] [from] [to] [amount]",
+			Short: "Move tokens of a clawback enabled denom out of an account",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "from"}, {ProtoField: "to"}, {ProtoField: "amount"}},
+		},
+		{
+			RpcMethod: "SetTransferFee",
+			Use: "set-transfer-fee [denom] [basis-points] [treasury]",
+			Short: "Set the fee charged on transfers of a denom; zero basis points removes it",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "basis_points"}, {ProtoField: "treasury", Optional: true}},
+		},
+		{
+			RpcMethod: "SetFeeDenom",
+			Skip: true, // skipped because authority gated
+		},
+		{
+			RpcMethod: "RemoveFeeDenom",
+			Skip: true, // skipped because authority gated
+		},
+		{
+			RpcMethod: "SubmitFeeDenomPrice",
+			Use: "submit-fee-denom-price [denom] [price]",
+			Short: "Submit the price of a fee denom in the base fee denom as one of its oracles",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "price"}},
+		},
+		{
+			RpcMethod: "DistributeToHolders",
+			Use: "distribute-to-holders [denom] [reward-coins]",
+			Short: "Distribute coins pro rata to the current holders of a denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "reward_coins", Varargs: true}},
+		},
+		{
+			RpcMethod: "ClaimDistribution",
+			Use: "claim-distribution [distribution-id]",
+			Short: "Claim your share of a distribution to the holders of a denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "distribution_id"}},
+		},
 		// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
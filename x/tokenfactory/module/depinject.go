@@ -3,11 +3,14 @@ package tokenfactory
 import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"nimo-chain/x/tokenfactory/keeper"
 	"nimo-chain/x/tokenfactory/types"
@@ -30,11 +33,20 @@ type ModuleInputs struct {
 
 	Config       *types.Module
 	StoreService store.KVStoreService
+	EventService event.Service
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper  types.AuthKeeper
+	BankKeeper  types.BankKeeper
+	DistrKeeper types.DistrKeeper
+	Erc20Keeper types.Erc20Keeper `optional:"true"`
+	EVMKeeper   types.EVMKeeper   `optional:"true"`
+	WasmKeeper  types.WasmKeeper  `optional:"true"`
+
+	ChannelKeeper types.ChannelKeeper `optional:"true"`
+
+	DenomMetadataKeeper types.DenomMetadataKeeper
 }
 
 type ModuleOutputs struct {
@@ -42,6 +54,11 @@ type ModuleOutputs struct {
 
 	TokenfactoryKeeper keeper.Keeper
 	Module             appmodule.AppModule
+
+	// SendRestrictionFn is appended to the x/bank send restrictions.
+	SendRestrictionFn banktypes.SendRestrictionFn
+	// EpochHooks resets per-epoch mint counters.
+	EpochHooks epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -52,13 +69,25 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 	k := keeper.NewKeeper(
 		in.StoreService,
+		in.EventService,
 		in.Cdc,
 		in.AddressCodec,
 		authority,
 		in.AuthKeeper,
 		in.BankKeeper,
+		in.DistrKeeper,
+		in.Erc20Keeper,
+		in.EVMKeeper,
+		in.WasmKeeper,
+		in.ChannelKeeper,
+		in.DenomMetadataKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{TokenfactoryKeeper: k, Module: m}
+	return ModuleOutputs{
+		TokenfactoryKeeper: k,
+		Module:             m,
+		SendRestrictionFn:  k.SendRestrictionFn,
+		EpochHooks:         epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()},
+	}
 }
@@ -0,0 +1,148 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	"nimo-chain/x/tokenfactory/keeper"
+)
+
+var (
+	_ porttypes.IBCModule = IBCMiddleware{}
+	_ ibcapi.IBCModule    = IBCMiddlewareV2{}
+)
+
+// IBCMiddleware wraps the ICS-20 transfer stack and records the escrow
+// address of every channel opened on it, so that the tokenfactory can tell
+// bridging transfers apart without scanning the channels.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and the
+// underlying application.
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	version, err := im.IBCModule.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+	if err != nil {
+		return "", err
+	}
+
+	return version, im.keeper.RecordEscrowAddress(ctx, portID, channelID)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	version, err := im.IBCModule.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+	if err != nil {
+		return "", err
+	}
+
+	return version, im.keeper.RecordEscrowAddress(ctx, portID, channelID)
+}
+
+// IBCMiddlewareV2 wraps the IBC v2 ICS-20 transfer stack. IBC v2 has no
+// channel handshake and escrows tokens per client, so the escrow address is
+// recorded on every packet callback before the transfer stack moves tokens
+// through it.
+type IBCMiddlewareV2 struct {
+	ibcapi.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddlewareV2 creates a new IBCMiddlewareV2 given the keeper and the
+// underlying application.
+func NewIBCMiddlewareV2(k keeper.Keeper, app ibcapi.IBCModule) IBCMiddlewareV2 {
+	return IBCMiddlewareV2{IBCModule: app, keeper: k}
+}
+
+// OnSendPacket implements the IBCModule interface.
+func (im IBCMiddlewareV2) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	if err := im.keeper.RecordEscrowAddress(ctx, payload.SourcePort, sourceClient); err != nil {
+		return err
+	}
+
+	return im.IBCModule.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddlewareV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	if err := im.keeper.RecordEscrowAddress(ctx, payload.DestinationPort, destinationClient); err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddlewareV2) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.keeper.RecordEscrowAddress(ctx, payload.SourcePort, sourceClient); err != nil {
+		return err
+	}
+
+	return im.IBCModule.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddlewareV2) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.keeper.RecordEscrowAddress(ctx, payload.SourcePort, sourceClient); err != nil {
+		return err
+	}
+
+	return im.IBCModule.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
@@ -18,12 +18,13 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	owner0, owner1 := sample.AccAddress(), sample.AccAddress()
 	tokenfactoryGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
-		DenomMap: []types.Denom{{Owner: sample.AccAddress(),
-			Denom: "0",
-		}, {Owner: sample.AccAddress(),
-			Denom: "1",
+		DenomMap: []types.Denom{{Owner: owner0,
+			Denom: types.ModuleDenomPrefix + "/" + owner0 + "/denom0",
+		}, {Owner: owner1,
+			Denom: types.ModuleDenomPrefix + "/" + owner1 + "/denom1",
 		}}}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&tokenfactoryGenesis)
 }
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ITokenFactory contract's address.
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The ITokenFactory contract's instance.
+ITokenFactory constant TOKENFACTORY_CONTRACT = ITokenFactory(TOKENFACTORY_PRECOMPILE_ADDRESS);
+
+/// @dev Denom is a factory denom as stored by the tokenfactory module.
+struct Denom {
+    /// @dev The full denom, factory/{creator}/{subdenom}
+    string denom;
+    /// @dev The owner, or the zero address once ownership was renounced
+    address owner;
+    string description;
+    string ticker;
+    /// @dev The number of decimals of the display unit
+    int64 precision;
+    string url;
+    uint256 maxSupply;
+    uint256 supply;
+    bool canChangeMaxSupply;
+    bool paused;
+}
+
+/// @dev PageRequest is a struct that represents a page request.
+struct PageRequest {
+    bytes key;
+    uint64 offset;
+    uint64 limit;
+    bool countTotal;
+    bool reverse;
+}
+
+/// @dev PageResponse is a struct that represents a page response.
+struct PageResponse {
+    bytes nextKey;
+    uint64 total;
+}
+
+/// @title TokenFactory Precompiled Contract
+/// @dev The interface through which solidity contracts create and manage
+/// factory denoms. The caller is authorized as the bech32 form of its
+/// address, so a contract acts as the owner of the denoms it creates.
+/// @custom:address 0x0000000000000000000000000000000000000900
+interface ITokenFactory {
+    /// @dev CreateDenom creates factory/{caller}/{subdenom} owned by the caller.
+    /// @param subdenom The last segment of the new denom
+    /// @param description The denom description
+    /// @param ticker The denom ticker
+    /// @param precision The number of decimals of the display unit
+    /// @param url The denom url
+    /// @param maxSupply The maximum supply of the denom
+    /// @param canChangeMaxSupply Whether the owner can change the max supply later
+    /// @return denom The full denom
+    function createDenom(
+        string calldata subdenom,
+        string calldata description,
+        string calldata ticker,
+        int64 precision,
+        string calldata url,
+        uint256 maxSupply,
+        bool canChangeMaxSupply
+    ) external returns (string memory denom);
+
+    /// @dev Mint mints amount of denom to recipient. The caller needs the minter role.
+    /// @param denom The factory denom
+    /// @param recipient The address receiving the tokens
+    /// @param amount The amount to mint
+    /// @return success true if the tokens were minted
+    function mint(
+        string calldata denom,
+        address recipient,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Burn burns amount of denom from the balance of the caller.
+    /// @param denom The factory denom
+    /// @param amount The amount to burn
+    /// @return success true if the tokens were burned
+    function burn(
+        string calldata denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev UpdateMetadata updates the description, url and max supply of a
+    /// denom. The caller needs the metadata admin role.
+    /// @param denom The factory denom
+    /// @param description The new description
+    /// @param url The new url
+    /// @param maxSupply The new maximum supply
+    /// @param canChangeMaxSupply Whether the max supply can change later
+    /// @return success true if the denom was updated
+    function updateMetadata(
+        string calldata denom,
+        string calldata description,
+        string calldata url,
+        uint256 maxSupply,
+        bool canChangeMaxSupply
+    ) external returns (bool success);
+
+    /// @dev TransferOwnership proposes newOwner for a denom owned by the
+    /// caller. The ownership moves once newOwner accepts it.
+    /// @param denom The factory denom
+    /// @param newOwner The proposed owner
+    /// @return success true if the ownership was proposed
+    function transferOwnership(
+        string calldata denom,
+        address newOwner
+    ) external returns (bool success);
+
+    /// @dev AcceptOwnership makes the caller the owner of a denom it was
+    /// proposed for.
+    /// @param denom The factory denom
+    /// @return success true if the ownership was transferred
+    function acceptOwnership(
+        string calldata denom
+    ) external returns (bool success);
+
+    /// @dev GetDenom returns a factory denom.
+    /// @param denom The factory denom
+    /// @return The stored denom
+    function getDenom(
+        string calldata denom
+    ) external view returns (Denom memory);
+
+    /// @dev GetDenomsByOwner returns the denoms owned by an address.
+    /// @param owner The owner
+    /// @param pagination Pagination configuration for the query
+    /// @return denoms The denoms of owner
+    /// @return pageResponse Pagination information for the response
+    function getDenomsByOwner(
+        address owner,
+        PageRequest calldata pagination
+    ) external view returns (Denom[] memory denoms, PageResponse memory pageResponse);
+}
@@ -0,0 +1,107 @@
+package precompile
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+const (
+	// GetDenomMethod defines the ABI method name for the GetDenom query.
+	GetDenomMethod = "getDenom"
+	// GetDenomsByOwnerMethod defines the ABI method name for the DenomsByOwner query.
+	GetDenomsByOwnerMethod = "getDenomsByOwner"
+)
+
+// GetDenom returns a factory denom.
+func (p Precompile) GetDenom(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[0])
+	}
+
+	res, err := p.queryServer.GetDenom(ctx, &types.QueryGetDenomRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := NewDenom(res.Denom, p.addressCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out)
+}
+
+// GetDenomsByOwner returns a page of the denoms owned by an address.
+func (p Precompile) GetDenomsByOwner(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DenomsByOwnerInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DenomsByOwnerInput: %s", err)
+	}
+
+	owner, err := p.addressCodec.BytesToString(input.Owner.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.queryServer.DenomsByOwner(ctx, &types.QueryDenomsByOwnerRequest{
+		Owner:      owner,
+		Pagination: &input.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := DenomsByOwnerOutput{Denoms: make([]Denom, len(res.Denoms))}
+	for i, denom := range res.Denoms {
+		if out.Denoms[i], err = NewDenom(denom, p.addressCodec); err != nil {
+			return nil, err
+		}
+	}
+	if res.Pagination != nil {
+		out.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+
+	return method.Outputs.Pack(out.Denoms, out.PageResponse)
+}
+
+// DenomsByOwnerInput is the input of the getDenomsByOwner method.
+type DenomsByOwnerInput struct {
+	Owner      common.Address    `abi:"owner"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// DenomsByOwnerOutput is the output of the getDenomsByOwner method.
+type DenomsByOwnerOutput struct {
+	Denoms       []Denom            `abi:"denoms"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
@@ -0,0 +1,210 @@
+package precompile
+
+import (
+	"embed"
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// PrecompileAddress is the fixed address of the tokenfactory precompile,
+// right after the range used by the cosmos/evm precompiles.
+const PrecompileAddress = "0x0000000000000000000000000000000000000900"
+
+// Gas charged up front for each method, on top of the store gas the method
+// consumes while it runs.
+const (
+	CreateDenomGas       uint64 = 100_000
+	MintGas              uint64 = 50_000
+	BurnGas              uint64 = 50_000
+	UpdateMetadataGas    uint64 = 30_000
+	TransferOwnershipGas uint64 = 30_000
+	AcceptOwnershipGas   uint64 = 30_000
+	GetDenomGas          uint64 = 5_000
+	GetDenomsByOwnerGas  uint64 = 10_000
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile exposes the tokenfactory msg and query servers to EVM contracts.
+type Precompile struct {
+	cmn.Precompile
+	msgServer    types.MsgServer
+	queryServer  types.QueryServer
+	addressCodec address.Codec
+}
+
+// LoadABI loads the tokenfactory ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates the tokenfactory precompile. The bank keeper tracks
+// balance changes of the EVM denom, such as a denom creation fee.
+func NewPrecompile(
+	msgServer types.MsgServer,
+	queryServer types.QueryServer,
+	bankKeeper cmn.BankKeeper,
+	addressCodec address.Codec,
+) (*Precompile, error) {
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		msgServer:    msgServer,
+		queryServer:  queryServer,
+		addressCodec: addressCodec,
+	}
+
+	p.SetAddress(common.HexToAddress(PrecompileAddress))
+	p.SetBalanceHandler(bankKeeper)
+
+	return p, nil
+}
+
+// RequiredGas returns the gas of the method called by input.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	switch method.Name {
+	case CreateDenomMethod:
+		return CreateDenomGas
+	case MintMethod:
+		return MintGas
+	case BurnMethod:
+		return BurnGas
+	case UpdateMetadataMethod:
+		return UpdateMetadataGas
+	case TransferOwnershipMethod:
+		return TransferOwnershipGas
+	case AcceptOwnershipMethod:
+		return AcceptOwnershipGas
+	case GetDenomMethod:
+		return GetDenomGas
+	case GetDenomsByOwnerMethod:
+		return GetDenomsByOwnerGas
+	default:
+		return 0
+	}
+}
+
+// Run executes the tokenfactory method called by the contract input.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	bz, err = p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start the balance change handler before executing the precompile.
+	p.GetBalanceHandler().BeforeBalanceChange(ctx)
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// tokenfactory transactions
+	case CreateDenomMethod:
+		bz, err = p.CreateDenom(ctx, method, contract, args)
+	case MintMethod:
+		bz, err = p.Mint(ctx, method, contract, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, method, contract, args)
+	case UpdateMetadataMethod:
+		bz, err = p.UpdateMetadata(ctx, method, contract, args)
+	case TransferOwnershipMethod:
+		bz, err = p.TransferOwnership(ctx, method, contract, args)
+	case AcceptOwnershipMethod:
+		bz, err = p.AcceptOwnership(ctx, method, contract, args)
+	// tokenfactory queries
+	case GetDenomMethod:
+		bz, err = p.GetDenom(ctx, method, contract, args)
+	case GetDenomsByOwnerMethod:
+		bz, err = p.GetDenomsByOwner(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	// Process the native balance changes after the method execution.
+	if err := p.GetBalanceHandler().AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction reports whether method writes state.
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case CreateDenomMethod,
+		MintMethod,
+		BurnMethod,
+		UpdateMetadataMethod,
+		TransferOwnershipMethod,
+		AcceptOwnershipMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", types.ModuleName)
+}
+
+// callerAddress returns the bech32 form of the EVM address calling the
+// precompile, which the tokenfactory messages are signed as.
+func (p Precompile) callerAddress(contract *vm.Contract) (string, error) {
+	return p.addressCodec.BytesToString(contract.Caller().Bytes())
+}
@@ -0,0 +1,148 @@
+package precompile_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/x/tokenfactory/precompile"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// recordingMsgServer records the messages the precompile sends.
+type recordingMsgServer struct {
+	types.UnimplementedMsgServer
+	msgs []sdk.Msg
+}
+
+func (s *recordingMsgServer) CreateDenom(_ context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	s.msgs = append(s.msgs, msg)
+	return &types.MsgCreateDenomResponse{NewTokenDenom: "factory/" + msg.Owner + "/" + msg.Subdenom}, nil
+}
+
+func (s *recordingMsgServer) MintAndSendTokens(_ context.Context, msg *types.MsgMintAndSendTokens) (*types.MsgMintAndSendTokensResponse, error) {
+	s.msgs = append(s.msgs, msg)
+	return &types.MsgMintAndSendTokensResponse{}, nil
+}
+
+func (s *recordingMsgServer) ProposeOwner(_ context.Context, msg *types.MsgProposeOwner) (*types.MsgProposeOwnerResponse, error) {
+	s.msgs = append(s.msgs, msg)
+	return &types.MsgProposeOwnerResponse{}, nil
+}
+
+func (s *recordingMsgServer) AcceptOwnership(_ context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
+	s.msgs = append(s.msgs, msg)
+	return &types.MsgAcceptOwnershipResponse{}, nil
+}
+
+// denomQueryServer serves a single denom.
+type denomQueryServer struct {
+	types.UnimplementedQueryServer
+	denom types.Denom
+}
+
+func (s *denomQueryServer) GetDenom(_ context.Context, _ *types.QueryGetDenomRequest) (*types.QueryGetDenomResponse, error) {
+	return &types.QueryGetDenomResponse{Denom: s.denom}, nil
+}
+
+func TestPrecompileTx(t *testing.T) {
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	msgServer := &recordingMsgServer{}
+	p, err := precompile.NewPrecompile(msgServer, &denomQueryServer{}, nil, addressCodec)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress(precompile.PrecompileAddress), p.Address())
+
+	caller := common.BytesToAddress([]byte("signerAddr__________"))
+	owner, err := addressCodec.BytesToString(caller.Bytes())
+	require.NoError(t, err)
+	contract := vm.NewContract(caller, p.Address(), uint256.NewInt(0), 1_000_000, nil)
+
+	// Every method has its own gas cost
+	method := p.Methods[precompile.CreateDenomMethod]
+	require.Equal(t, precompile.CreateDenomGas, p.RequiredGas(method.ID))
+	require.Equal(t, precompile.GetDenomGas, p.RequiredGas(p.Methods[precompile.GetDenomMethod].ID))
+	require.True(t, p.IsTransaction(&method))
+
+	// The caller signs as the bech32 form of its EVM address
+	bz, err := p.CreateDenom(sdk.Context{}, &method, contract, []interface{}{
+		"token", "description", "TKN", int64(6), "", big.NewInt(100), true,
+	})
+	require.NoError(t, err)
+	out, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+owner+"/token", out[0])
+	require.Equal(t, &types.MsgCreateDenom{
+		Owner:              owner,
+		Subdenom:           "token",
+		Description:        "description",
+		Ticker:             "TKN",
+		Precision:          6,
+		MaxSupply:          math.NewInt(100),
+		CanChangeMaxSupply: true,
+	}, msgServer.msgs[0])
+
+	recipient := common.BytesToAddress([]byte("holderAddr__________"))
+	method = p.Methods[precompile.MintMethod]
+	_, err = p.Mint(sdk.Context{}, &method, contract, []interface{}{"factory/" + owner + "/token", recipient, big.NewInt(10)})
+	require.NoError(t, err)
+	mint := msgServer.msgs[1].(*types.MsgMintAndSendTokens)
+	require.Equal(t, owner, mint.Creator)
+	require.Equal(t, math.NewInt(10), mint.Amount)
+
+	// Ownership cannot go to the zero address
+	method = p.Methods[precompile.TransferOwnershipMethod]
+	_, err = p.TransferOwnership(sdk.Context{}, &method, contract, []interface{}{"factory/" + owner + "/token", common.Address{}})
+	require.Error(t, err)
+	require.Len(t, msgServer.msgs, 2)
+
+	// Transfers are proposed and the new owner accepts them
+	newOwnerAddr := common.BytesToAddress([]byte("newOwnerAddr________"))
+	newOwner, err := addressCodec.BytesToString(newOwnerAddr.Bytes())
+	require.NoError(t, err)
+	_, err = p.TransferOwnership(sdk.Context{}, &method, contract, []interface{}{"factory/" + owner + "/token", newOwnerAddr})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgProposeOwner{Creator: owner, Denom: "factory/" + owner + "/token", NewOwner: newOwner}, msgServer.msgs[2])
+
+	method = p.Methods[precompile.AcceptOwnershipMethod]
+	require.True(t, p.IsTransaction(&method))
+	_, err = p.AcceptOwnership(sdk.Context{}, &method, vm.NewContract(newOwnerAddr, p.Address(), uint256.NewInt(0), 1_000_000, nil), []interface{}{"factory/" + owner + "/token"})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgAcceptOwnership{NewOwner: newOwner, Denom: "factory/" + owner + "/token"}, msgServer.msgs[3])
+}
+
+func TestPrecompileGetDenom(t *testing.T) {
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	ownerAddr := common.BytesToAddress([]byte("signerAddr__________"))
+	owner, err := addressCodec.BytesToString(ownerAddr.Bytes())
+	require.NoError(t, err)
+
+	denom := types.Denom{Denom: "factory/" + owner + "/token", Owner: owner, Ticker: "TKN", Precision: 6, MaxSupply: math.NewInt(100), Supply: math.NewInt(5)}
+	p, err := precompile.NewPrecompile(&recordingMsgServer{}, &denomQueryServer{denom: denom}, nil, addressCodec)
+	require.NoError(t, err)
+
+	method := p.Methods[precompile.GetDenomMethod]
+	require.False(t, p.IsTransaction(&method))
+	bz, err := p.GetDenom(sdk.Context{}, &method, nil, []interface{}{denom.Denom})
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	out := *abi.ConvertType(values[0], new(precompile.Denom)).(*precompile.Denom)
+	require.Equal(t, ownerAddr, out.Owner)
+	require.Equal(t, big.NewInt(5), out.Supply)
+
+	// A renounced denom has no owner
+	denom.Owner = ""
+	renounced, err := precompile.NewDenom(denom, addressCodec)
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}, renounced.Owner)
+}
@@ -0,0 +1,342 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+const (
+	// CreateDenomMethod defines the ABI method name for MsgCreateDenom.
+	CreateDenomMethod = "createDenom"
+	// MintMethod defines the ABI method name for MsgMintAndSendTokens.
+	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for MsgBurn.
+	BurnMethod = "burn"
+	// UpdateMetadataMethod defines the ABI method name for MsgUpdateDenom.
+	UpdateMetadataMethod = "updateMetadata"
+	// TransferOwnershipMethod defines the ABI method name for MsgProposeOwner.
+	TransferOwnershipMethod = "transferOwnership"
+	// AcceptOwnershipMethod defines the ABI method name for MsgAcceptOwnership.
+	AcceptOwnershipMethod = "acceptOwnership"
+)
+
+// CreateDenom creates factory/{caller}/{subdenom} owned by the caller and
+// returns the full denom.
+func (p Precompile) CreateDenom(
+	ctx sdk.Context,
+	method *abi.Method,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	owner, err := p.callerAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	subdenom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid subdenom: %v", args[0])
+	}
+	description, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid description: %v", args[1])
+	}
+	ticker, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid ticker: %v", args[2])
+	}
+	precision, ok := args[3].(int64)
+	if !ok {
+		return nil, fmt.Errorf("invalid precision: %v", args[3])
+	}
+	url, ok := args[4].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid url: %v", args[4])
+	}
+	maxSupply, err := parseAmount(args[5])
+	if err != nil {
+		return nil, err
+	}
+	canChangeMaxSupply, ok := args[6].(bool)
+	if !ok {
+		return nil, fmt.Errorf("invalid canChangeMaxSupply: %v", args[6])
+	}
+
+	msg := &types.MsgCreateDenom{
+		Owner:              owner,
+		Subdenom:           subdenom,
+		Description:        description,
+		Ticker:             ticker,
+		Precision:          precision,
+		Url:                url,
+		MaxSupply:          maxSupply,
+		CanChangeMaxSupply: canChangeMaxSupply,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.msgServer.CreateDenom(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.NewTokenDenom)
+}
+
+// Mint mints amount of denom to recipient. The caller needs the minter role.
+func (p Precompile) Mint(
+	ctx sdk.Context,
+	method *abi.Method,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	creator, err := p.callerAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[0])
+	}
+	recipientAddr, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid recipient: %v", args[1])
+	}
+	recipient, err := p.addressCodec.BytesToString(recipientAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgMintAndSendTokens{
+		Creator:   creator,
+		Denom:     denom,
+		Amount:    amount,
+		Recipient: recipient,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.msgServer.MintAndSendTokens(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Burn burns amount of denom from the balance of the caller.
+func (p Precompile) Burn(
+	ctx sdk.Context,
+	method *abi.Method,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	creator, err := p.callerAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[0])
+	}
+	amount, err := parseAmount(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgBurn{
+		Creator: creator,
+		Denom:   denom,
+		Amount:  amount,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.msgServer.Burn(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UpdateMetadata updates the description, url and max supply of a denom. The
+// caller needs the metadata admin role.
+func (p Precompile) UpdateMetadata(
+	ctx sdk.Context,
+	method *abi.Method,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	owner, err := p.callerAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[0])
+	}
+	description, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid description: %v", args[1])
+	}
+	url, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid url: %v", args[2])
+	}
+	maxSupply, err := parseAmount(args[3])
+	if err != nil {
+		return nil, err
+	}
+	canChangeMaxSupply, ok := args[4].(bool)
+	if !ok {
+		return nil, fmt.Errorf("invalid canChangeMaxSupply: %v", args[4])
+	}
+
+	msg := &types.MsgUpdateDenom{
+		Owner:              owner,
+		Denom:              denom,
+		Description:        description,
+		Url:                url,
+		MaxSupply:          maxSupply,
+		CanChangeMaxSupply: canChangeMaxSupply,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.msgServer.UpdateDenom(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// TransferOwnership proposes a new owner for a denom owned by the caller. The
+// ownership moves once the new owner accepts it.
+func (p Precompile) TransferOwnership(
+	ctx sdk.Context,
+	method *abi.Method,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	creator, err := p.callerAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[0])
+	}
+	newOwnerAddr, ok := args[1].(common.Address)
+	if !ok || newOwnerAddr == (common.Address{}) {
+		return nil, fmt.Errorf("invalid new owner: %v", args[1])
+	}
+	newOwner, err := p.addressCodec.BytesToString(newOwnerAddr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgProposeOwner{
+		Creator:  creator,
+		Denom:    denom,
+		NewOwner: newOwner,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.msgServer.ProposeOwner(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// AcceptOwnership makes the caller the owner of a denom it was proposed for.
+func (p Precompile) AcceptOwnership(
+	ctx sdk.Context,
+	method *abi.Method,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	newOwner, err := p.callerAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom: %v", args[0])
+	}
+
+	msg := &types.MsgAcceptOwnership{
+		NewOwner: newOwner,
+		Denom:    denom,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.msgServer.AcceptOwnership(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// parseAmount converts a uint256 argument to math.Int.
+func parseAmount(arg interface{}) (math.Int, error) {
+	amount, ok := arg.(*big.Int)
+	if !ok || amount == nil {
+		return math.Int{}, fmt.Errorf(cmn.ErrInvalidAmount, arg)
+	}
+
+	if amount.BitLen() > math.MaxBitLen {
+		return math.Int{}, fmt.Errorf("amount %s out of bounds", amount)
+	}
+
+	return math.NewIntFromBigInt(amount), nil
+}
@@ -0,0 +1,58 @@
+package precompile
+
+import (
+	"math/big"
+
+	"cosmossdk.io/core/address"
+	"github.com/ethereum/go-ethereum/common"
+
+	"nimo-chain/x/tokenfactory/types"
+)
+
+// Denom is the ABI form of types.Denom.
+type Denom struct {
+	Denom              string         `abi:"denom"`
+	Owner              common.Address `abi:"owner"`
+	Description        string         `abi:"description"`
+	Ticker             string         `abi:"ticker"`
+	Precision          int64          `abi:"precision"`
+	Url                string         `abi:"url"`
+	MaxSupply          *big.Int       `abi:"maxSupply"`
+	Supply             *big.Int       `abi:"supply"`
+	CanChangeMaxSupply bool           `abi:"canChangeMaxSupply"`
+	Paused             bool           `abi:"paused"`
+}
+
+// NewDenom converts a stored denom to its ABI form. A renounced denom has no
+// owner and maps to the zero address.
+func NewDenom(denom types.Denom, addressCodec address.Codec) (Denom, error) {
+	var owner common.Address
+	if denom.Owner != "" {
+		bz, err := addressCodec.StringToBytes(denom.Owner)
+		if err != nil {
+			return Denom{}, err
+		}
+		owner = common.BytesToAddress(bz)
+	}
+
+	out := Denom{
+		Denom:              denom.Denom,
+		Owner:              owner,
+		Description:        denom.Description,
+		Ticker:             denom.Ticker,
+		Precision:          denom.Precision,
+		Url:                denom.Url,
+		MaxSupply:          big.NewInt(0),
+		Supply:             big.NewInt(0),
+		CanChangeMaxSupply: denom.CanChangeMaxSupply,
+		Paused:             denom.Paused,
+	}
+	if !denom.MaxSupply.IsNil() {
+		out.MaxSupply = denom.MaxSupply.BigInt()
+	}
+	if !denom.Supply.IsNil() {
+		out.Supply = denom.Supply.BigInt()
+	}
+
+	return out, nil
+}
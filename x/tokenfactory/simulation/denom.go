@@ -4,6 +4,8 @@ import (
 	"math/rand"
 	"strconv"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,11 +28,17 @@ func SimulateMsgCreateDenom(
 
 		i := r.Int()
 		msg := &types.MsgCreateDenom{
-			Owner: simAccount.Address.String(),
-			Denom: strconv.Itoa(i),
+			Owner:     simAccount.Address.String(),
+			Subdenom:  "denom" + strconv.Itoa(i),
+			MaxSupply: math.NewInt(1_000_000),
+		}
+
+		denom, err := types.GetTokenDenom(msg.Owner, msg.Subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "invalid subdenom"), nil, nil
 		}
 
-		found, err := k.Denom.Has(ctx, msg.Denom)
+		found, err := k.Denom.Has(ctx, denom)
 		if err == nil && found {
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Denom already exist"), nil, nil
 		}
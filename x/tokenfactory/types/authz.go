@@ -0,0 +1,167 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is charged for every allowlist entry checked, like the
+// bank SendAuthorization does.
+const gasCostPerIteration = uint64(10)
+
+var (
+	_ authz.Authorization = &MintAuthorization{}
+	_ authz.Authorization = &DenomAdminAuthorization{}
+)
+
+// NewMintAuthorization creates a new MintAuthorization object.
+func NewMintAuthorization(spendLimit sdk.Coins, allowedRecipients []string) *MintAuthorization {
+	return &MintAuthorization{SpendLimit: spendLimit, AllowedRecipients: allowedRecipients}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MintAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMintAndSendTokens{})
+}
+
+// Accept implements Authorization.Accept.
+func (a MintAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mint, ok := msg.(*MsgMintAndSendTokens)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if mint.Amount.IsNil() || !mint.Amount.IsPositive() {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("amount must be positive")
+	}
+
+	// Denoms missing from the spend limit cannot be minted at all
+	limitLeft, isNegative := a.SpendLimit.SafeSub(sdk.NewCoin(mint.Denom, mint.Amount))
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than mint limit")
+	}
+
+	if len(a.AllowedRecipients) > 0 {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		allowed := false
+		for _, recipient := range a.AllowedRecipients {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "mint authorization")
+			if recipient == mint.Recipient {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot mint to %s address", mint.Recipient)
+		}
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &MintAuthorization{SpendLimit: limitLeft, AllowedRecipients: a.AllowedRecipients}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MintAuthorization) ValidateBasic() error {
+	if len(a.SpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap("spend limit must be valid and positive")
+	}
+	for _, coin := range a.SpendLimit {
+		if _, _, err := DeconstructDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return validateAuthorizationList(a.AllowedRecipients, func(recipient string) error {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed recipient %s: %s", recipient, err)
+		}
+		return nil
+	})
+}
+
+// NewDenomAdminAuthorization creates a new DenomAdminAuthorization object. A
+// nil maxSupplyLimit keeps the grantee from changing the max supply.
+func NewDenomAdminAuthorization(denoms []string, maxSupplyLimit *math.Int, allowMaxSupplyLock bool) *DenomAdminAuthorization {
+	return &DenomAdminAuthorization{Denoms: denoms, MaxSupplyLimit: maxSupplyLimit, AllowMaxSupplyLock: allowMaxSupplyLock}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a DenomAdminAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgUpdateDenom{})
+}
+
+// Accept implements Authorization.Accept.
+func (a DenomAdminAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	update, ok := msg.(*MsgUpdateDenom)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !slices.Contains(a.Denoms, update.Denom) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot update denom %s", update.Denom)
+	}
+
+	// An unset max supply leaves the supply settings untouched
+	if update.MaxSupply.IsNil() {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	if a.MaxSupplyLimit == nil {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("cannot change the max supply")
+	}
+	if update.MaxSupply.GT(*a.MaxSupplyLimit) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("max supply %s is above the limit %s", update.MaxSupply, a.MaxSupplyLimit)
+	}
+	if !update.CanChangeMaxSupply && !a.AllowMaxSupplyLock {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("cannot lock the max supply")
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a DenomAdminAuthorization) ValidateBasic() error {
+	if len(a.Denoms) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("denoms cannot be empty")
+	}
+
+	if a.MaxSupplyLimit != nil && (a.MaxSupplyLimit.IsNil() || !a.MaxSupplyLimit.IsPositive()) {
+		return sdkerrors.ErrInvalidRequest.Wrap("max supply limit must be positive")
+	}
+	if a.AllowMaxSupplyLock && a.MaxSupplyLimit == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("locking the max supply requires a max supply limit")
+	}
+
+	return validateAuthorizationList(a.Denoms, func(denom string) error {
+		_, _, err := DeconstructDenom(denom)
+		return err
+	})
+}
+
+// validateAuthorizationList checks every entry of list and that none is
+// duplicated.
+func validateAuthorizationList(list []string, validate func(string) error) error {
+	seen := make(map[string]struct{}, len(list))
+	for _, entry := range list {
+		if err := validate(entry); err != nil {
+			return err
+		}
+		if _, ok := seen[entry]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicated entry %s", entry)
+		}
+		seen[entry] = struct{}{}
+	}
+
+	return nil
+}
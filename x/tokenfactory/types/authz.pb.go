@@ -0,0 +1,690 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nimochain/tokenfactory/v1/authz.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintAuthorization allows the grantee to execute MsgMintAndSendTokens on
+// behalf of the granter. Only the denoms of spend_limit can be minted, up to
+// their amount, which counts down with every mint. When allowed_recipients is
+// set, tokens can only be minted to those addresses.
+type MintAuthorization struct {
+	SpendLimit        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	AllowedRecipients []string                                 `protobuf:"bytes,2,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *MintAuthorization) Reset()         { *m = MintAuthorization{} }
+func (m *MintAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MintAuthorization) ProtoMessage()    {}
+func (*MintAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70436680b03a9d19, []int{0}
+}
+func (m *MintAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAuthorization.Merge(m, src)
+}
+func (m *MintAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAuthorization proto.InternalMessageInfo
+
+func (m *MintAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *MintAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+// DenomAdminAuthorization allows the grantee to execute MsgUpdateDenom on
+// behalf of the granter for the listed denoms. Unless max_supply_limit is set,
+// the grantee can only update the description and url, and must leave the max
+// supply unset.
+type DenomAdminAuthorization struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// max_supply_limit allows the grantee to change the max supply up to this
+	// amount.
+	MaxSupplyLimit *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_supply_limit,json=maxSupplyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply_limit,omitempty"`
+	// allow_max_supply_lock allows the grantee to lock the max supply for good
+	// by clearing can_change_max_supply. It requires max_supply_limit.
+	AllowMaxSupplyLock bool `protobuf:"varint,3,opt,name=allow_max_supply_lock,json=allowMaxSupplyLock,proto3" json:"allow_max_supply_lock,omitempty"`
+}
+
+func (m *DenomAdminAuthorization) Reset()         { *m = DenomAdminAuthorization{} }
+func (m *DenomAdminAuthorization) String() string { return proto.CompactTextString(m) }
+func (*DenomAdminAuthorization) ProtoMessage()    {}
+func (*DenomAdminAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70436680b03a9d19, []int{1}
+}
+func (m *DenomAdminAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAdminAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAdminAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAdminAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAdminAuthorization.Merge(m, src)
+}
+func (m *DenomAdminAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAdminAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAdminAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAdminAuthorization proto.InternalMessageInfo
+
+func (m *DenomAdminAuthorization) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *DenomAdminAuthorization) GetAllowMaxSupplyLock() bool {
+	if m != nil {
+		return m.AllowMaxSupplyLock
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MintAuthorization)(nil), "nimochain.tokenfactory.v1.MintAuthorization")
+	proto.RegisterType((*DenomAdminAuthorization)(nil), "nimochain.tokenfactory.v1.DenomAdminAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("nimochain/tokenfactory/v1/authz.proto", fileDescriptor_70436680b03a9d19)
+}
+
+var fileDescriptor_70436680b03a9d19 = []byte{
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x53, 0xa9, 0x22, 0x57, 0x84, 0x48, 0xd4, 0x42, 0xd2, 0xc1, 0x89, 0x22, 0x21, 0xa2,
+	0x48, 0xf1, 0x61, 0x98, 0xe8, 0x96, 0x80, 0x40, 0x95, 0xe8, 0x80, 0x2b, 0x16, 0x18, 0xac, 0x8b,
+	0x7d, 0xd8, 0x27, 0xfb, 0xee, 0x59, 0xbe, 0x4b, 0x48, 0x3a, 0x32, 0x32, 0x31, 0x30, 0xf1, 0x0b,
+	0x10, 0x53, 0x86, 0x0c, 0xfc, 0x84, 0x8a, 0xa9, 0xea, 0x84, 0x18, 0x0a, 0x4a, 0x86, 0xfc, 0x0d,
+	0x64, 0xfb, 0x08, 0x8d, 0xa0, 0x12, 0x8b, 0x7d, 0xef, 0xde, 0xfb, 0xde, 0xf7, 0xbd, 0xef, 0x1e,
+	0xba, 0x23, 0x18, 0x07, 0x2f, 0x24, 0x4c, 0x60, 0x05, 0x11, 0x15, 0xaf, 0x89, 0xa7, 0x20, 0x9d,
+	0xe2, 0xb1, 0x8d, 0xc9, 0x48, 0x85, 0x27, 0x56, 0x92, 0x82, 0x82, 0x5a, 0x63, 0x5d, 0x66, 0x5d,
+	0x2e, 0xb3, 0xc6, 0xf6, 0x7e, 0x95, 0x70, 0x26, 0x00, 0xe7, 0xdf, 0xa2, 0x7a, 0xdf, 0xf4, 0x40,
+	0x72, 0x90, 0x78, 0x48, 0x24, 0xc5, 0x63, 0x7b, 0x48, 0x15, 0xb1, 0xb1, 0x07, 0x4c, 0xe8, 0x7c,
+	0xa3, 0xc8, 0xbb, 0x79, 0x84, 0x8b, 0x40, 0xa7, 0x76, 0x03, 0x08, 0xa0, 0xb8, 0xcf, 0x4e, 0xc5,
+	0x6d, 0xfb, 0x4b, 0x19, 0x55, 0x8f, 0x98, 0x50, 0xfd, 0x91, 0x0a, 0x21, 0x65, 0x27, 0x44, 0x31,
+	0x10, 0xb5, 0xb7, 0x06, 0xda, 0x91, 0x09, 0x15, 0xbe, 0x1b, 0x33, 0xce, 0x54, 0xdd, 0x68, 0x6d,
+	0x75, 0x76, 0xee, 0x37, 0x2c, 0xdd, 0x30, 0x63, 0xb7, 0x34, 0xbb, 0xf5, 0x08, 0x98, 0x18, 0x3c,
+	0x39, 0xbd, 0x68, 0x96, 0x3e, 0xff, 0x68, 0x76, 0x02, 0xa6, 0xc2, 0xd1, 0xd0, 0xf2, 0x80, 0x6b,
+	0x76, 0xfd, 0xeb, 0x49, 0x3f, 0xc2, 0x6a, 0x9a, 0x50, 0x99, 0x03, 0xe4, 0xc7, 0xd5, 0xac, 0x7b,
+	0x3d, 0xa6, 0x01, 0xf1, 0xa6, 0x6e, 0xa6, 0x5f, 0x7e, 0x5a, 0xcd, 0xba, 0x86, 0x83, 0x72, 0xd6,
+	0x67, 0x19, 0x69, 0xed, 0x29, 0xaa, 0x91, 0x38, 0x86, 0x37, 0xd4, 0x77, 0x53, 0xea, 0xb1, 0x84,
+	0x51, 0xa1, 0x64, 0xbd, 0xdc, 0xda, 0xea, 0x54, 0x06, 0xf5, 0xf3, 0x79, 0x6f, 0x57, 0xab, 0xe9,
+	0xfb, 0x7e, 0x4a, 0xa5, 0x3c, 0x56, 0x29, 0x13, 0x81, 0x53, 0xd5, 0x18, 0x67, 0x0d, 0x39, 0x78,
+	0xf1, 0x75, 0xde, 0x6b, 0xeb, 0xe2, 0xc2, 0xfa, 0xdf, 0xda, 0x37, 0xa6, 0x7e, 0xb7, 0x9a, 0x75,
+	0xbb, 0x7f, 0x1e, 0x6d, 0xb2, 0xf9, 0x6c, 0x7f, 0x99, 0xd4, 0xfe, 0x50, 0x46, 0xb7, 0x1f, 0x53,
+	0x01, 0xbc, 0xef, 0x73, 0x26, 0x36, 0x0d, 0xbc, 0x85, 0xb6, 0xfd, 0x2c, 0x25, 0x73, 0xeb, 0x2a,
+	0x8e, 0x8e, 0x6a, 0xcf, 0xd1, 0x4d, 0x4e, 0x26, 0xae, 0x1c, 0x25, 0x49, 0x3c, 0xd5, 0xe6, 0x96,
+	0x5b, 0x46, 0xa7, 0x32, 0xb8, 0xfb, 0xfd, 0xa2, 0xb9, 0x57, 0x88, 0x94, 0x7e, 0x64, 0x31, 0xc0,
+	0x9c, 0xa8, 0xd0, 0x3a, 0x14, 0xea, 0x7c, 0xde, 0x43, 0x5a, 0xfd, 0xa1, 0x50, 0xce, 0x0d, 0x4e,
+	0x26, 0xc7, 0x39, 0xbe, 0xb0, 0xc9, 0x46, 0x7b, 0xf9, 0xc8, 0xee, 0xe5, 0xc6, 0xe0, 0x45, 0xf5,
+	0xad, 0x96, 0xd1, 0xb9, 0xe6, 0x14, 0x1e, 0x1e, 0xad, 0x31, 0xe0, 0x45, 0x07, 0xaf, 0xfe, 0xdf,
+	0x90, 0x7b, 0x57, 0x1a, 0x72, 0xc5, 0xe8, 0x83, 0x87, 0xa7, 0x0b, 0xd3, 0x38, 0x5b, 0x98, 0xc6,
+	0xcf, 0x85, 0x69, 0xbc, 0x5f, 0x9a, 0xa5, 0xb3, 0xa5, 0x59, 0xfa, 0xb6, 0x34, 0x4b, 0x2f, 0x9b,
+	0x59, 0xaf, 0xde, 0x3f, 0x9b, 0xe5, 0x9b, 0x31, 0xdc, 0xce, 0x77, 0xf2, 0xc1, 0xaf, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0xb5, 0x72, 0x0c, 0x23, 0x3b, 0x03, 0x00, 0x00,
+}
+
+func (m *MintAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomAdminAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAdminAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAdminAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowMaxSupplyLock {
+		i--
+		if m.AllowMaxSupplyLock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSupplyLimit != nil {
+		{
+			size := m.MaxSupplyLimit.Size()
+			i -= size
+			if _, err := m.MaxSupplyLimit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomAdminAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxSupplyLimit != nil {
+		l = m.MaxSupplyLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.AllowMaxSupplyLock {
+		n += 2
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomAdminAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAdminAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAdminAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupplyLimit = &v
+			if err := m.MaxSupplyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMaxSupplyLock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowMaxSupplyLock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
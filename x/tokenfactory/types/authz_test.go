@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/testutil/sample"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMintAuthorization(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	granter := sample.AccAddress()
+	recipient := sample.AccAddress()
+	token := "factory/" + granter + "/token"
+	other := "factory/" + granter + "/other"
+
+	require.Error(t, types.NewMintAuthorization(nil, nil).ValidateBasic())
+	require.Error(t, types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin("unimo", 1)), nil).ValidateBasic())
+	require.Error(t, types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin(token, 1)), []string{recipient, recipient}).ValidateBasic())
+
+	auth := types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin(token, 100)), []string{recipient})
+	require.NoError(t, auth.ValidateBasic())
+
+	mint := func(denom, to string, amount int64) *types.MsgMintAndSendTokens {
+		return &types.MsgMintAndSendTokens{Creator: granter, Denom: denom, Amount: math.NewInt(amount), Recipient: to}
+	}
+
+	_, err := auth.Accept(ctx, mint(other, recipient, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = auth.Accept(ctx, mint(token, sample.AccAddress(), 1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, mint(token, recipient, 101))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// The limit counts down and the grant is deleted once it is used up
+	resp, err := auth.Accept(ctx, mint(token, recipient, 60))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewMintAuthorization(sdk.NewCoins(sdk.NewInt64Coin(token, 40)), []string{recipient}), resp.Updated)
+
+	resp, err = resp.Updated.Accept(ctx, mint(token, recipient, 40))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
+
+func TestDenomAdminAuthorization(t *testing.T) {
+	owner := sample.AccAddress()
+	token := "factory/" + owner + "/token"
+
+	require.Error(t, types.NewDenomAdminAuthorization(nil, nil, false).ValidateBasic())
+	require.Error(t, types.NewDenomAdminAuthorization([]string{"unimo"}, nil, false).ValidateBasic())
+	require.Error(t, types.NewDenomAdminAuthorization([]string{token, token}, nil, false).ValidateBasic())
+
+	auth := types.NewDenomAdminAuthorization([]string{token}, nil, false)
+	require.NoError(t, auth.ValidateBasic())
+
+	resp, err := auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+
+	_, err = auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: "factory/" + owner + "/other"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(context.Background(), &types.MsgMintAndSendTokens{Denom: token})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestDenomAdminAuthorizationMaxSupply(t *testing.T) {
+	owner := sample.AccAddress()
+	token := "factory/" + owner + "/token"
+	limit := math.NewInt(1_000)
+
+	zero := math.ZeroInt()
+	require.Error(t, types.NewDenomAdminAuthorization([]string{token}, &zero, false).ValidateBasic())
+	require.Error(t, types.NewDenomAdminAuthorization([]string{token}, nil, true).ValidateBasic())
+
+	// Without a limit the max supply cannot be touched
+	auth := types.NewDenomAdminAuthorization([]string{token}, nil, false)
+	_, err := auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: math.NewInt(500), CanChangeMaxSupply: true})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: math.NewInt(500)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// With a limit it can change up to the limit but not be locked
+	auth = types.NewDenomAdminAuthorization([]string{token}, &limit, false)
+	require.NoError(t, auth.ValidateBasic())
+	resp, err := auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: limit, CanChangeMaxSupply: true})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	_, err = auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: limit.AddRaw(1), CanChangeMaxSupply: true})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: limit})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	auth = types.NewDenomAdminAuthorization([]string{token}, &limit, true)
+	require.NoError(t, auth.ValidateBasic())
+	resp, err = auth.Accept(context.Background(), &types.MsgUpdateDenom{Owner: owner, Denom: token, MaxSupply: limit})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+}
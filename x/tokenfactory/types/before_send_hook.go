@@ -0,0 +1,22 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// BeforeSendHookGasLimit caps the gas a before-send hook contract may use on
+// a single transfer, so a hook cannot make every transfer of a denom
+// arbitrarily expensive.
+const BeforeSendHookGasLimit uint64 = 500_000
+
+// BlockBeforeSendSudoMsg is the sudo message passed to the before-send hook
+// contract of a denom. The contract rejects the transfer by returning an
+// error.
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BlockBeforeSend `json:"block_before_send"`
+}
+
+// BlockBeforeSend describes the transfer of a single coin.
+type BlockBeforeSend struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
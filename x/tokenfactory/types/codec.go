@@ -4,9 +4,75 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*authz.Authorization)(nil),
+		&MintAuthorization{},
+		&DenomAdminAuthorization{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDistributeToHolders{},
+		&MsgClaimDistribution{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFeeDenom{},
+		&MsgRemoveFeeDenom{},
+		&MsgSubmitFeeDenomPrice{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMultiMint{},
+		&MsgMintVested{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgForceTransfer{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetTransferFee{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBeforeSendHook{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeOwner{},
+		&MsgAcceptOwnership{},
+		&MsgCancelOwnershipProposal{},
+		&MsgRenounceOwnership{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetMinterAllowance{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPauseDenom{},
+		&MsgUnpauseDenom{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBurn{},
+		&MsgBurnFrom{},
+		&MsgApproveBurn{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateOwner{},
 	)
@@ -28,4 +94,4 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
-}
\ No newline at end of file
+}
@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,15 +27,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Denom defines the Denom message.
 type Denom struct {
-	Denom              string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Description        string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Ticker             string `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	Precision          int64  `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
-	Url                string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
-	MaxSupply          int64  `protobuf:"varint,6,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
-	Supply             int64  `protobuf:"varint,7,opt,name=supply,proto3" json:"supply,omitempty"`
-	CanChangeMaxSupply bool   `protobuf:"varint,8,opt,name=can_change_max_supply,json=canChangeMaxSupply,proto3" json:"can_change_max_supply,omitempty"`
-	Owner              string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	Denom              string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Description        string                `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Ticker             string                `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Precision          int64                 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	Url                string                `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	MaxSupply          cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	Supply             cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	CanChangeMaxSupply bool                  `protobuf:"varint,8,opt,name=can_change_max_supply,json=canChangeMaxSupply,proto3" json:"can_change_max_supply,omitempty"`
+	Owner              string                `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	// paused blocks every transfer, mint and burn of the denom.
+	Paused bool `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	// clawback_enabled allows the owner and holders of the clawback role to
+	// force-transfer the denom. It is fixed when the denom is created.
+	ClawbackEnabled bool `protobuf:"varint,11,opt,name=clawback_enabled,json=clawbackEnabled,proto3" json:"clawback_enabled,omitempty"`
+	// transfer_fee is charged on top of every transfer of the denom when set.
+	TransferFee *TransferFee `protobuf:"bytes,12,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -103,36 +113,108 @@ func (m *Denom) GetUrl() string {
 	return ""
 }
 
-func (m *Denom) GetMaxSupply() int64 {
+func (m *Denom) GetCanChangeMaxSupply() bool {
 	if m != nil {
-		return m.MaxSupply
+		return m.CanChangeMaxSupply
 	}
-	return 0
+	return false
 }
 
-func (m *Denom) GetSupply() int64 {
+func (m *Denom) GetOwner() string {
 	if m != nil {
-		return m.Supply
+		return m.Owner
 	}
-	return 0
+	return ""
 }
 
-func (m *Denom) GetCanChangeMaxSupply() bool {
+func (m *Denom) GetPaused() bool {
 	if m != nil {
-		return m.CanChangeMaxSupply
+		return m.Paused
 	}
 	return false
 }
 
-func (m *Denom) GetOwner() string {
+func (m *Denom) GetClawbackEnabled() bool {
 	if m != nil {
-		return m.Owner
+		return m.ClawbackEnabled
+	}
+	return false
+}
+
+func (m *Denom) GetTransferFee() *TransferFee {
+	if m != nil {
+		return m.TransferFee
+	}
+	return nil
+}
+
+// TransferFee defines the fee charged on transfers of a denom. The sender pays
+// basis_points of the amount to the treasury in addition to the amount.
+// Transfers from or to the treasury, an exempt address, a module account or
+// an IBC escrow account are not charged.
+type TransferFee struct {
+	BasisPoints     uint32   `protobuf:"varint,1,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	Treasury        string   `protobuf:"bytes,2,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	ExemptAddresses []string `protobuf:"bytes,3,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+}
+
+func (m *TransferFee) Reset()         { *m = TransferFee{} }
+func (m *TransferFee) String() string { return proto.CompactTextString(m) }
+func (*TransferFee) ProtoMessage()    {}
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85bc0256918eeb31, []int{1}
+}
+func (m *TransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFee.Merge(m, src)
+}
+func (m *TransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFee proto.InternalMessageInfo
+
+func (m *TransferFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *TransferFee) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
 	}
 	return ""
 }
 
+func (m *TransferFee) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Denom)(nil), "nimochain.tokenfactory.v1.Denom")
+	proto.RegisterType((*TransferFee)(nil), "nimochain.tokenfactory.v1.TransferFee")
 }
 
 func init() {
@@ -140,26 +222,39 @@ func init() {
 }
 
 var fileDescriptor_85bc0256918eeb31 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x1c, 0xc4, 0xeb, 0xf6, 0x4b, 0xbf, 0xc6, 0x2c, 0xc8, 0x2a, 0xc8, 0x48, 0x60, 0x22, 0x24, 0xa4,
-	0x2c, 0x24, 0x8a, 0x98, 0x58, 0x81, 0x95, 0x25, 0x6c, 0x2c, 0x91, 0x71, 0x0d, 0xb5, 0xda, 0xd8,
-	0x96, 0xe3, 0x96, 0xe4, 0x09, 0x58, 0x79, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x14, 0x27,
-	0x2a, 0x61, 0xcb, 0xdd, 0xef, 0xee, 0x1f, 0xf9, 0xe0, 0xa5, 0x14, 0xb9, 0x62, 0x4b, 0x2a, 0x64,
-	0x6c, 0xd5, 0x8a, 0xcb, 0x17, 0xca, 0xac, 0x32, 0x55, 0xbc, 0x4d, 0xe2, 0x05, 0x97, 0x2a, 0x8f,
-	0xb4, 0x51, 0x56, 0xa1, 0x93, 0x7d, 0x2c, 0x1a, 0xc6, 0xa2, 0x6d, 0x72, 0xf1, 0x3e, 0x86, 0xde,
-	0x7d, 0x1b, 0x45, 0x73, 0xe8, 0xb9, 0x0e, 0x06, 0x01, 0x08, 0xfd, 0xb4, 0x13, 0x28, 0x80, 0x07,
-	0x0b, 0x5e, 0x30, 0x23, 0xb4, 0x15, 0x4a, 0xe2, 0xb1, 0x63, 0x43, 0x0b, 0x1d, 0xc3, 0xa9, 0x15,
-	0x6c, 0xc5, 0x0d, 0x9e, 0x38, 0xd8, 0x2b, 0x74, 0x0a, 0x7d, 0x6d, 0x38, 0x13, 0x45, 0xdb, 0xfb,
-	0x17, 0x80, 0x70, 0x92, 0xfe, 0x1a, 0xe8, 0x10, 0x4e, 0x36, 0x66, 0x8d, 0x3d, 0x57, 0x69, 0x3f,
-	0xd1, 0x19, 0x84, 0x39, 0x2d, 0xb3, 0x62, 0xa3, 0xf5, 0xba, 0xc2, 0xd3, 0xae, 0x90, 0xd3, 0xf2,
-	0xd1, 0x19, 0xed, 0x6f, 0x7a, 0xf4, 0xdf, 0xa1, 0x5e, 0xa1, 0x04, 0x1e, 0x31, 0x2a, 0x33, 0xb6,
-	0xa4, 0xf2, 0x95, 0x67, 0x83, 0x0b, 0xb3, 0x00, 0x84, 0xb3, 0x14, 0x31, 0x2a, 0xef, 0x1c, 0x7b,
-	0xd8, 0x9f, 0x9a, 0x43, 0x4f, 0xbd, 0x49, 0x6e, 0xb0, 0xdf, 0xbd, 0xd4, 0x89, 0xdb, 0x9b, 0xcf,
-	0x9a, 0x80, 0x5d, 0x4d, 0xc0, 0x77, 0x4d, 0xc0, 0x47, 0x43, 0x46, 0xbb, 0x86, 0x8c, 0xbe, 0x1a,
-	0x32, 0x7a, 0x3a, 0x6f, 0xe7, 0xbb, 0xea, 0x66, 0x2e, 0xff, 0x0e, 0x6d, 0x2b, 0xcd, 0x8b, 0xe7,
-	0xa9, 0x9b, 0xf9, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x4c, 0x26, 0x0b, 0x8f, 0x01, 0x00,
-	0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0x08, 0x2d, 0xab, 0x33, 0xb4, 0xc9, 0xea, 0x90, 0x37, 0xa1, 0x34, 0x4c, 0x02, 0x15,
+	0xa1, 0xa6, 0x2a, 0x70, 0xe1, 0xb8, 0x16, 0x90, 0x8a, 0x84, 0x84, 0x32, 0x4e, 0x5c, 0x22, 0xd7,
+	0xf9, 0xda, 0x46, 0x6d, 0xec, 0xc8, 0x76, 0xb7, 0xf6, 0x2d, 0x78, 0x0f, 0xae, 0x7b, 0x88, 0x1d,
+	0xa7, 0x9d, 0x10, 0x87, 0x09, 0xb5, 0x0f, 0xc0, 0x2b, 0xa0, 0xd8, 0x59, 0x57, 0x0e, 0x20, 0x71,
+	0xf3, 0xef, 0xdf, 0xe7, 0x7c, 0x5f, 0x3e, 0xa3, 0xa7, 0x3c, 0xcd, 0x04, 0x9b, 0xd0, 0x94, 0x77,
+	0xb4, 0x98, 0x02, 0x1f, 0x51, 0xa6, 0x85, 0x5c, 0x76, 0xce, 0xba, 0x9d, 0x04, 0xb8, 0xc8, 0xc2,
+	0x5c, 0x0a, 0x2d, 0xf0, 0xe1, 0xc6, 0x16, 0x6e, 0xdb, 0xc2, 0xb3, 0xee, 0xd1, 0x21, 0x13, 0x2a,
+	0x13, 0x2a, 0x36, 0xc6, 0x8e, 0x05, 0x36, 0x75, 0xd4, 0x18, 0x8b, 0xb1, 0xb0, 0x7c, 0x71, 0xb2,
+	0xec, 0xf1, 0x2f, 0x17, 0x55, 0xdf, 0x16, 0xb5, 0x71, 0x03, 0x55, 0xcd, 0x25, 0xc4, 0x09, 0x9c,
+	0x56, 0x3d, 0xb2, 0x00, 0x07, 0xc8, 0x4b, 0x40, 0x31, 0x99, 0xe6, 0x3a, 0x15, 0x9c, 0xdc, 0x33,
+	0xda, 0x36, 0x85, 0x1f, 0xa1, 0x9a, 0x4e, 0xd9, 0x14, 0x24, 0x71, 0x8d, 0x58, 0x22, 0xfc, 0x18,
+	0xd5, 0x73, 0x09, 0x2c, 0x55, 0x45, 0xee, 0x7e, 0xe0, 0xb4, 0xdc, 0xe8, 0x8e, 0xc0, 0xfb, 0xc8,
+	0x9d, 0xcb, 0x19, 0xa9, 0x9a, 0x48, 0x71, 0xc4, 0x1f, 0x10, 0xca, 0xe8, 0x22, 0x56, 0xf3, 0x3c,
+	0x9f, 0x2d, 0x49, 0xad, 0x10, 0x7a, 0x2f, 0x2e, 0x6f, 0x9a, 0x95, 0x1f, 0x37, 0xcd, 0x03, 0xdb,
+	0x89, 0x4a, 0xa6, 0x61, 0x2a, 0x3a, 0x19, 0xd5, 0x93, 0x70, 0xc0, 0xf5, 0xf5, 0x45, 0x1b, 0x95,
+	0x2d, 0x0e, 0xb8, 0x8e, 0xea, 0x19, 0x5d, 0x9c, 0x9a, 0x34, 0xee, 0xa3, 0x5a, 0x59, 0xe7, 0xc1,
+	0xff, 0xd7, 0x29, 0xa3, 0xb8, 0x8b, 0x0e, 0x18, 0xe5, 0x31, 0x9b, 0x50, 0x3e, 0x86, 0x78, 0xeb,
+	0xdb, 0x76, 0x02, 0xa7, 0xb5, 0x13, 0x61, 0x46, 0x79, 0xdf, 0x68, 0x1f, 0x37, 0xf7, 0x36, 0x50,
+	0x55, 0x9c, 0x73, 0x90, 0xa4, 0x6e, 0x67, 0x68, 0x40, 0x31, 0xa1, 0x9c, 0xce, 0x15, 0x24, 0x04,
+	0x99, 0x64, 0x89, 0xf0, 0x73, 0xb4, 0xcf, 0x66, 0xf4, 0x7c, 0x48, 0xd9, 0x34, 0x06, 0x4e, 0x87,
+	0x33, 0x48, 0x88, 0x67, 0x1c, 0x7b, 0xb7, 0xfc, 0x3b, 0x4b, 0xe3, 0x01, 0xda, 0xd5, 0x92, 0x72,
+	0x35, 0x02, 0x19, 0x8f, 0x00, 0xc8, 0x6e, 0xe0, 0xb4, 0xbc, 0x97, 0xcf, 0xc2, 0xbf, 0x6e, 0x42,
+	0xf8, 0xb9, 0xb4, 0xbf, 0x07, 0x88, 0x3c, 0x7d, 0x07, 0x8e, 0xbf, 0x39, 0xc8, 0xdb, 0x12, 0xf1,
+	0x13, 0xb4, 0x3b, 0xa4, 0x2a, 0x55, 0x71, 0x2e, 0x52, 0xae, 0x95, 0xf9, 0xfd, 0x0f, 0x23, 0xcf,
+	0x70, 0x9f, 0x0c, 0x85, 0x5f, 0xa3, 0x1d, 0x2d, 0x81, 0xaa, 0xb9, 0x5c, 0xda, 0x0d, 0xe8, 0x91,
+	0xeb, 0x8b, 0x76, 0xa3, 0x9c, 0xd9, 0x49, 0x92, 0x48, 0x50, 0xea, 0x54, 0xcb, 0x94, 0x8f, 0xa3,
+	0x8d, 0x13, 0xf7, 0xd1, 0x3e, 0x2c, 0x20, 0xcb, 0x75, 0x4c, 0xad, 0x03, 0x14, 0x71, 0x03, 0xf7,
+	0x9f, 0xe9, 0x3d, 0x9b, 0x38, 0xb9, 0x0d, 0xf4, 0xde, 0x5c, 0xae, 0x7c, 0xe7, 0x6a, 0xe5, 0x3b,
+	0x3f, 0x57, 0xbe, 0xf3, 0x75, 0xed, 0x57, 0xae, 0xd6, 0x7e, 0xe5, 0xfb, 0xda, 0xaf, 0x7c, 0x69,
+	0x16, 0xbd, 0xb7, 0xed, 0x6b, 0x59, 0xfc, 0xf9, 0x5e, 0xf4, 0x32, 0x07, 0x35, 0xac, 0x99, 0x0d,
+	0x7f, 0xf5, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x0a, 0x9d, 0x23, 0x56, 0x03, 0x00, 0x00,
 }
 
 func (m *Denom) Marshal() (dAtA []byte, err error) {
@@ -182,6 +277,38 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransferFee != nil {
+		{
+			size, err := m.TransferFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDenom(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -199,16 +326,26 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.Supply != 0 {
-		i = encodeVarintDenom(dAtA, i, uint64(m.Supply))
-		i--
-		dAtA[i] = 0x38
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDenom(dAtA, i, uint64(size))
 	}
-	if m.MaxSupply != 0 {
-		i = encodeVarintDenom(dAtA, i, uint64(m.MaxSupply))
-		i--
-		dAtA[i] = 0x30
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDenom(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.Url) > 0 {
 		i -= len(m.Url)
 		copy(dAtA[i:], m.Url)
@@ -245,6 +382,50 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintDenom(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintDenom(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintDenom(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDenom(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDenom(v)
 	base := offset
@@ -281,12 +462,10 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDenom(uint64(l))
 	}
-	if m.MaxSupply != 0 {
-		n += 1 + sovDenom(uint64(m.MaxSupply))
-	}
-	if m.Supply != 0 {
-		n += 1 + sovDenom(uint64(m.Supply))
-	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovDenom(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovDenom(uint64(l))
 	if m.CanChangeMaxSupply {
 		n += 2
 	}
@@ -294,6 +473,38 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDenom(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	if m.TransferFee != nil {
+		l = m.TransferFee.Size()
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		n += 1 + sovDenom(uint64(m.BasisPoints))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovDenom(uint64(l))
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovDenom(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -480,10 +691,10 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
-			m.MaxSupply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDenom
@@ -493,16 +704,31 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSupply |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
-			m.Supply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDenom
@@ -512,11 +738,26 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Supply |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CanChangeMaxSupply", wireType)
@@ -569,6 +810,215 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEnabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferFee == nil {
+				m.TransferFee = &TransferFee{}
+			}
+			if err := m.TransferFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDenom(dAtA[iNdEx:])
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ClawbackDisabledNotice is added to the bank metadata description of denoms
+// created without clawback, so holders can see that the issuer cannot move
+// their tokens.
+const ClawbackDisabledNotice = "clawback disabled: the issuer cannot force-transfer this token"
+
+// BankMetadata builds the x/bank metadata describing the denom. The base unit
+// is the factory denom itself; when the denom has a precision, a display unit
+// named after the lower-cased ticker is added with that exponent. Denoms
+// without a ticker fall back to their subdenom.
+func (d Denom) BankMetadata() (banktypes.Metadata, error) {
+	if d.Precision < 0 {
+		return banktypes.Metadata{}, errorsmod.Wrap(ErrInvalidMetadata, "precision cannot be negative")
+	}
+
+	symbol := d.Ticker
+	if symbol == "" {
+		symbol = strings.ToUpper(d.Denom[strings.LastIndex(d.Denom, "/")+1:])
+	}
+
+	metadata := banktypes.Metadata{
+		Description: d.metadataDescription(),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: d.Denom, Exponent: 0},
+		},
+		Base:    d.Denom,
+		Display: d.Denom,
+		Name:    symbol,
+		Symbol:  symbol,
+		URI:     d.Url,
+	}
+
+	if d.Precision > 0 {
+		display := strings.ToLower(symbol)
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: uint32(d.Precision),
+		})
+		metadata.Display = display
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	return metadata, nil
+}
+
+// ValidateDisplayUnit checks that a denom created with the given subdenom,
+// ticker and precision gets valid bank metadata. Its display unit is the
+// lower-cased ticker, or subdenom without a ticker, and like any denom must be
+// 3 to 128 characters long.
+func ValidateDisplayUnit(subdenom, ticker string, precision int64) error {
+	if ticker != "" {
+		if err := sdk.ValidateDenom(strings.ToLower(ticker)); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMetadata, "ticker %s must be 3 to 128 characters, start with a letter and contain only letters, digits and /:._-", ticker)
+		}
+		return nil
+	}
+
+	if precision > 0 {
+		if err := sdk.ValidateDenom(strings.ToLower(subdenom)); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMetadata, "subdenom %s cannot name the display unit, set a ticker of 3 to 128 characters", subdenom)
+		}
+	}
+
+	return nil
+}
+
+// metadataDescription returns the description of the denom, followed by
+// ClawbackDisabledNotice unless clawback is enabled.
+func (d Denom) metadataDescription() string {
+	if d.ClawbackEnabled {
+		return d.Description
+	}
+
+	if d.Description == "" {
+		return ClawbackDisabledNotice
+	}
+
+	return fmt.Sprintf("%s (%s)", d.Description, ClawbackDisabledNotice)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleDenomPrefix is the first segment of every factory denom.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom segment.
+	MaxSubdenomLength = 44
+)
+
+// ReservedSubdenomPrefixes lists prefixes that cannot start a subdenom, so a
+// factory denom cannot pass itself off as an IBC voucher, an ERC-20 pair or a
+// nested factory denom.
+var ReservedSubdenomPrefixes = []string{"ibc", "erc20", ModuleDenomPrefix}
+
+// subdenomRegex requires a leading letter followed by letters, digits, '.', '_' or '-'.
+var subdenomRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9._-]*$`)
+
+// GetTokenDenom builds the full denom factory/{creator}/{subdenom} and checks
+// the result is a valid sdk denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if err := ValidateSubdenom(subdenom); err != nil {
+		return "", err
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory denom into its creator and subdenom,
+// validating every segment.
+func DeconstructDenom(denom string) (creator string, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom %s must have the format %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	subdenom = parts[2]
+	if err := ValidateSubdenom(subdenom); err != nil {
+		return "", "", err
+	}
+
+	return creator, subdenom, nil
+}
+
+// ValidateSubdenom checks the subdenom against the length and charset rules,
+// the chain bond denom and the reserved prefixes.
+func ValidateSubdenom(subdenom string) error {
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return errorsmod.Wrapf(ErrInvalidDenom, "subdenom length must be between 1 and %d", MaxSubdenomLength)
+	}
+
+	if !subdenomRegex.MatchString(subdenom) {
+		return errorsmod.Wrapf(ErrInvalidDenom, "invalid subdenom %s", subdenom)
+	}
+
+	lower := strings.ToLower(subdenom)
+	if lower == strings.ToLower(sdk.DefaultBondDenom) {
+		return errorsmod.Wrapf(ErrInvalidDenom, "subdenom cannot be the bond denom %s", sdk.DefaultBondDenom)
+	}
+
+	for _, prefix := range ReservedSubdenomPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return errorsmod.Wrap(ErrInvalidDenom, fmt.Sprintf("subdenom cannot start with reserved prefix %s", prefix))
+		}
+	}
+
+	return nil
+}
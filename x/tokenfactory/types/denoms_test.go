@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/testutil/sample"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sample.AccAddress()
+
+	tests := []struct {
+		desc     string
+		subdenom string
+		valid    bool
+	}{
+		{desc: "simple", subdenom: "bitcoin", valid: true},
+		{desc: "with separators", subdenom: "my-token_v1.0", valid: true},
+		{desc: "max length", subdenom: "a" + strings.Repeat("b", types.MaxSubdenomLength-1), valid: true},
+		{desc: "empty", subdenom: "", valid: false},
+		{desc: "too long", subdenom: strings.Repeat("a", types.MaxSubdenomLength+1), valid: false},
+		{desc: "leading digit", subdenom: "1token", valid: false},
+		{desc: "slash", subdenom: "a/b", valid: false},
+		{desc: "bond denom", subdenom: sdk.DefaultBondDenom, valid: false},
+		{desc: "bond denom upper case", subdenom: strings.ToUpper(sdk.DefaultBondDenom), valid: false},
+		{desc: "ibc prefix", subdenom: "ibcToken", valid: false},
+		{desc: "erc20 prefix", subdenom: "erc20token", valid: false},
+		{desc: "factory prefix", subdenom: "factory", valid: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(creator, tc.subdenom)
+			if !tc.valid {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+
+			gotCreator, gotSubdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sample.AccAddress()
+
+	for _, denom := range []string{
+		"uatom",
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		"factory/" + creator,
+		"factory/" + creator + "/token/extra",
+		"foundry/" + creator + "/token",
+		"factory/notanaddress/token",
+	} {
+		_, _, err := types.DeconstructDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidDenom, denom)
+	}
+}
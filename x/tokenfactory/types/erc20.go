@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ERC20Address derives the address of the ERC-20 token pair of a factory
+// denom from the hash of the denom, so it is the same on every node.
+func ERC20Address(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(denom)))
+}
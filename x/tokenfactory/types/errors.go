@@ -8,6 +8,11 @@ import (
 
 // x/tokenfactory module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	
+	ErrInvalidSigner   = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidDenom    = errors.Register(ModuleName, 1101, "invalid denom")
+	ErrInvalidMetadata = errors.Register(ModuleName, 1102, "invalid denom metadata")
+	ErrDenomPaused     = errors.Register(ModuleName, 1103, "denom is paused")
+	ErrBeforeSendHook  = errors.Register(ModuleName, 1104, "before send hook rejected the transfer")
+	ErrNotFeeDenom     = errors.Register(ModuleName, 1105, "denom is not accepted for fees")
+	ErrStaleFeeRate    = errors.Register(ModuleName, 1106, "no fee denom price within the twap window")
 )
@@ -4,24 +4,83 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
 type AuthKeeper interface {
 	AddressCodec() address.Codec
-	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+	NewAccount(context.Context, sdk.AccountI) sdk.AccountI
+	SetAccount(context.Context, sdk.AccountI)
+	GetModulePermissions() map[string]authtypes.PermissionsForAddress
 	// Methods imported from account should be defined here
 }
 
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	// Methods imported from bank should be defined here
 }
 
+// DenomMetadataKeeper removes x/bank metadata, which the bank keeper has no
+// method for.
+type DenomMetadataKeeper interface {
+	RemoveDenomMetaData(ctx context.Context, denom string) error
+}
+
+// DistrKeeper defines the expected interface for the Distribution module.
+type DistrKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// Erc20Keeper defines the expected interface for the ERC-20 module.
+type Erc20Keeper interface {
+	IsERC20Enabled(ctx sdk.Context) bool
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	SetToken(ctx sdk.Context, pair erc20types.TokenPair) error
+	EnableDynamicPrecompile(ctx sdk.Context, address common.Address) error
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	DeleteTokenPair(ctx sdk.Context, tokenPair erc20types.TokenPair)
+	DeleteDynamicPrecompile(ctx sdk.Context, precompile common.Address)
+	UnRegisterERC20CodeHash(ctx sdk.Context, erc20Addr common.Address) error
+}
+
+// EVMKeeper defines the expected interface for the EVM module.
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+}
+
+// WasmKeeper defines the expected interface for the CosmWasm module.
+type WasmKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ChannelKeeper defines the expected interface for the IBC channel keeper.
+type ChannelKeeper interface {
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTwapWindow is the longest TWAP window of a fee denom, in seconds, which
+// keeps the window start within the range of a time.Duration.
+const MaxTwapWindow = 30 * 24 * 60 * 60
+
+// Validate checks that the fee denom has exactly one rate source: a positive
+// fixed rate, or oracles and a TWAP window.
+func (f FeeDenom) Validate() error {
+	if _, _, err := DeconstructDenom(f.Denom); err != nil {
+		return err
+	}
+
+	hasFixedRate := !f.FixedRate.IsNil() && !f.FixedRate.IsZero()
+	if hasFixedRate && !f.FixedRate.IsPositive() {
+		return fmt.Errorf("fixed rate of fee denom %s must be positive", f.Denom)
+	}
+
+	hasOracles := len(f.Oracles) > 0 || f.TwapWindow > 0
+	if hasFixedRate == hasOracles {
+		return fmt.Errorf("fee denom %s must have either a fixed rate or oracles", f.Denom)
+	}
+	if hasOracles {
+		if len(f.Oracles) == 0 || f.TwapWindow == 0 {
+			return fmt.Errorf("fee denom %s needs both oracles and a twap window", f.Denom)
+		}
+		if f.TwapWindow > MaxTwapWindow {
+			return fmt.Errorf("twap window of fee denom %s exceeds %ds", f.Denom, MaxTwapWindow)
+		}
+
+		seen := make(map[string]struct{}, len(f.Oracles))
+		for _, oracle := range f.Oracles {
+			if _, err := sdk.AccAddressFromBech32(oracle); err != nil {
+				return fmt.Errorf("invalid oracle address %s: %w", oracle, err)
+			}
+			if _, ok := seen[oracle]; ok {
+				return fmt.Errorf("duplicated oracle %s", oracle)
+			}
+			seen[oracle] = struct{}{}
+		}
+	}
+
+	return nil
+}
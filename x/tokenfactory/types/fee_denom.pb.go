@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nimochain/tokenfactory/v1/fee_denom.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeDenom defines a factory denom that is accepted for transaction fees. The
+// conversion rate is the amount of the base fee denom that one unit of the
+// denom is worth. It is either the fixed_rate, or the time weighted average of
+// the prices submitted by the oracles over the last twap_window seconds. Fees
+// paid in the denom go to its owner, which pays their value in the base fee
+// denom into the fee collector.
+type FeeDenom struct {
+	Denom      string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	FixedRate  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fixed_rate,json=fixedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fixed_rate"`
+	Oracles    []string                    `protobuf:"bytes,3,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	TwapWindow uint64                      `protobuf:"varint,4,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bdb648f935ae77, []int{0}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetOracles() []string {
+	if m != nil {
+		return m.Oracles
+	}
+	return nil
+}
+
+func (m *FeeDenom) GetTwapWindow() uint64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+// FeeDenomPrice defines a price of a fee denom submitted by one of its oracles.
+type FeeDenomPrice struct {
+	Denom string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Time  time.Time                   `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *FeeDenomPrice) Reset()         { *m = FeeDenomPrice{} }
+func (m *FeeDenomPrice) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomPrice) ProtoMessage()    {}
+func (*FeeDenomPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bdb648f935ae77, []int{1}
+}
+func (m *FeeDenomPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomPrice.Merge(m, src)
+}
+func (m *FeeDenomPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomPrice proto.InternalMessageInfo
+
+func (m *FeeDenomPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenomPrice) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*FeeDenom)(nil), "nimochain.tokenfactory.v1.FeeDenom")
+	proto.RegisterType((*FeeDenomPrice)(nil), "nimochain.tokenfactory.v1.FeeDenomPrice")
+}
+
+func init() {
+	proto.RegisterFile("nimochain/tokenfactory/v1/fee_denom.proto", fileDescriptor_f3bdb648f935ae77)
+}
+
+var fileDescriptor_f3bdb648f935ae77 = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbd, 0xae, 0xd3, 0x30,
+	0x14, 0x8e, 0x69, 0x2f, 0xdc, 0xfa, 0x8a, 0x25, 0xea, 0x90, 0x5b, 0xa4, 0xa4, 0xba, 0x53, 0x19,
+	0xea, 0xa8, 0x97, 0x05, 0x46, 0xa2, 0x0a, 0x16, 0x86, 0xab, 0x80, 0x84, 0xc4, 0x12, 0xb9, 0xce,
+	0x49, 0x6a, 0xb5, 0x89, 0xa3, 0xd8, 0xf4, 0xe7, 0x2d, 0xfa, 0x18, 0x3c, 0x40, 0xdf, 0x81, 0x8e,
+	0x55, 0x27, 0xc4, 0x50, 0x50, 0xfb, 0x22, 0xc8, 0x76, 0x83, 0x60, 0x60, 0x61, 0xf3, 0xf9, 0xfc,
+	0x9d, 0xe3, 0xef, 0xfb, 0x7c, 0xf0, 0xf3, 0x92, 0x17, 0x82, 0x4d, 0x29, 0x2f, 0x43, 0x25, 0x66,
+	0x50, 0x66, 0x94, 0x29, 0x51, 0xaf, 0xc3, 0xc5, 0x28, 0xcc, 0x00, 0x92, 0x14, 0x4a, 0x51, 0x90,
+	0xaa, 0x16, 0x4a, 0xb8, 0xb7, 0xbf, 0xa9, 0xe4, 0x4f, 0x2a, 0x59, 0x8c, 0x7a, 0xb7, 0x4c, 0xc8,
+	0x42, 0xc8, 0xc4, 0x10, 0x43, 0x5b, 0xd8, 0xae, 0x5e, 0x37, 0x17, 0xb9, 0xb0, 0xb8, 0x3e, 0x5d,
+	0xd0, 0x20, 0x17, 0x22, 0x9f, 0x43, 0x68, 0xaa, 0xc9, 0xe7, 0x2c, 0x54, 0xbc, 0x00, 0xa9, 0x68,
+	0x51, 0x59, 0xc2, 0xdd, 0x57, 0x84, 0xaf, 0xdf, 0x00, 0x8c, 0xf5, 0xfb, 0x6e, 0x17, 0x5f, 0x19,
+	0x21, 0x1e, 0xea, 0xa3, 0x41, 0x27, 0xb6, 0x85, 0xfb, 0x80, 0x71, 0xc6, 0x57, 0x90, 0x26, 0x35,
+	0x55, 0xe0, 0x3d, 0xd2, 0x57, 0xd1, 0x68, 0x77, 0x0c, 0x9c, 0xef, 0xc7, 0xe0, 0x99, 0xd5, 0x20,
+	0xd3, 0x19, 0xe1, 0x22, 0x2c, 0xa8, 0x9a, 0x92, 0x77, 0x90, 0x53, 0xb6, 0x1e, 0x03, 0x3b, 0x6c,
+	0x87, 0xf8, 0x22, 0x71, 0x0c, 0x2c, 0xee, 0x98, 0x21, 0x31, 0x55, 0xe0, 0xde, 0xe3, 0x27, 0xa2,
+	0xa6, 0x6c, 0x0e, 0xd2, 0x6b, 0xf5, 0x5b, 0x83, 0x4e, 0xe4, 0x1d, 0xb6, 0xc3, 0xee, 0x85, 0xfb,
+	0x3a, 0x4d, 0x6b, 0x90, 0xf2, 0xbd, 0xaa, 0x79, 0x99, 0xc7, 0x0d, 0xd1, 0x0d, 0xf0, 0x8d, 0x5a,
+	0xd2, 0x2a, 0x59, 0xf2, 0x32, 0x15, 0x4b, 0xaf, 0xdd, 0x47, 0x83, 0x76, 0x8c, 0x35, 0xf4, 0xd1,
+	0x20, 0x77, 0x5f, 0x10, 0x7e, 0xda, 0x38, 0x79, 0xa8, 0x39, 0x83, 0x7f, 0xd8, 0x79, 0x89, 0xdb,
+	0x3a, 0x04, 0x63, 0xe4, 0xe6, 0xbe, 0x47, 0x6c, 0x42, 0xa4, 0x49, 0x88, 0x7c, 0x68, 0x12, 0x8a,
+	0xae, 0xb5, 0xc9, 0xcd, 0x8f, 0x00, 0xc5, 0xa6, 0xc3, 0x7d, 0x8b, 0xaf, 0x2a, 0x3d, 0xd8, 0x6b,
+	0xfd, 0x6f, 0x06, 0xb6, 0x3f, 0x7a, 0xb5, 0x3b, 0xf9, 0x68, 0x7f, 0xf2, 0xd1, 0xcf, 0x93, 0x8f,
+	0x36, 0x67, 0xdf, 0xd9, 0x9f, 0x7d, 0xe7, 0xdb, 0xd9, 0x77, 0x3e, 0x05, 0xfa, 0xef, 0x87, 0x76,
+	0x4f, 0x56, 0x7f, 0x6f, 0x8a, 0x5a, 0x57, 0x20, 0x27, 0x8f, 0x8d, 0xce, 0x17, 0xbf, 0x02, 0x00,
+	0x00, 0xff, 0xff, 0xee, 0x2b, 0x02, 0xb3, 0x50, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapWindow != 0 {
+		i = encodeVarintFeeDenom(dAtA, i, uint64(m.TwapWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintFeeDenom(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.FixedRate.Size()
+		i -= size
+		if _, err := m.FixedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeDenom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeDenom(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeDenom(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeeDenom(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeDenom(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeDenom(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeDenom(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeDenom(uint64(l))
+	}
+	l = m.FixedRate.Size()
+	n += 1 + l + sovFeeDenom(uint64(l))
+	if len(m.Oracles) > 0 {
+		for _, s := range m.Oracles {
+			l = len(s)
+			n += 1 + l + sovFeeDenom(uint64(l))
+		}
+	}
+	if m.TwapWindow != 0 {
+		n += 1 + sovFeeDenom(uint64(m.TwapWindow))
+	}
+	return n
+}
+
+func (m *FeeDenomPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeDenom(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFeeDenom(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovFeeDenom(uint64(l))
+	return n
+}
+
+func sovFeeDenom(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeDenom(x uint64) (n int) {
+	return sovFeeDenom(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeDenom(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeDenom
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeDenom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeDenom
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeDenom
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeDenom
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeDenom        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeDenom          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeDenom = fmt.Errorf("proto: unexpected end of group")
+)
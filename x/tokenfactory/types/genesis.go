@@ -1,26 +1,295 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		DenomMap: []Denom{}}
+		Params:         DefaultParams(),
+		DenomMap:       []Denom{},
+		BurnAllowances: []BurnAllowance{},
+		FrozenAccounts: []FrozenAccount{},
+		RoleGrants:     []RoleGrant{},
+		EpochMints:     []EpochMint{},
+
+		OwnershipProposals: []OwnershipProposal{},
+		BeforeSendHooks:    []BeforeSendHook{},
+		SupplyCheckpoints:  []SupplyCheckpoint{},
+		FeeDenoms:          []FeeDenom{},
+		FeeDenomPrices:     []FeeDenomPrice{},
+
+		Distributions:        []Distribution{},
+		DistributionBalances: []DistributionBalance{},
+		DistributionClaims:   []DistributionClaim{},
+		EscrowAddresses:      []string{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
 	denomIndexMap := make(map[string]struct{})
+	clawbackDenoms := make(map[string]struct{})
 
 	for _, elem := range gs.DenomMap {
+		if _, _, err := DeconstructDenom(elem.Denom); err != nil {
+			return err
+		}
 		index := fmt.Sprint(elem.Denom)
 		if _, ok := denomIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for denom")
 		}
 		denomIndexMap[index] = struct{}{}
+		if elem.ClawbackEnabled {
+			clawbackDenoms[elem.Denom] = struct{}{}
+		}
+		if elem.TransferFee != nil {
+			if err := elem.TransferFee.Validate(); err != nil {
+				return fmt.Errorf("invalid transfer fee for denom %s: %w", elem.Denom, err)
+			}
+			if err := gs.Params.ValidateTransferFee(*elem.TransferFee); err != nil {
+				return fmt.Errorf("invalid transfer fee for denom %s: %w", elem.Denom, err)
+			}
+		}
+	}
+
+	burnAllowanceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BurnAllowances {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("burn allowance for unknown denom %s", elem.Denom)
+		}
+		if elem.Amount.IsNil() || !elem.Amount.IsPositive() {
+			return fmt.Errorf("burn allowance for denom %s must be positive", elem.Denom)
+		}
+		index := elem.Denom + "/" + elem.Holder
+		if _, ok := burnAllowanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for burn allowance")
+		}
+		burnAllowanceIndexMap[index] = struct{}{}
+	}
+
+	frozenAccountIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FrozenAccounts {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("frozen account for unknown denom %s", elem.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid frozen account address %s: %w", elem.Address, err)
+		}
+		index := elem.Denom + "/" + elem.Address
+		if _, ok := frozenAccountIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for frozen account")
+		}
+		frozenAccountIndexMap[index] = struct{}{}
+	}
+
+	roleGrantIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RoleGrants {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("role grant for unknown denom %s", elem.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid role grant address %s: %w", elem.Address, err)
+		}
+		if len(elem.Roles) == 0 {
+			return fmt.Errorf("role grant for %s on denom %s has no roles", elem.Address, elem.Denom)
+		}
+		for _, role := range elem.Roles {
+			if !role.IsValid() {
+				return fmt.Errorf("invalid role %s for denom %s", role, elem.Denom)
+			}
+			if _, ok := clawbackDenoms[elem.Denom]; role == ROLE_CLAWBACK && !ok {
+				return fmt.Errorf("clawback role for denom %s without clawback", elem.Denom)
+			}
+		}
+		if err := validateMintLimit(elem.Roles, elem.MintAllowance); err != nil {
+			return err
+		}
+		if err := validateMintLimit(elem.Roles, elem.PerEpochLimit); err != nil {
+			return err
+		}
+		index := elem.Denom + "/" + elem.Address
+		if _, ok := roleGrantIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for role grant")
+		}
+		roleGrantIndexMap[index] = struct{}{}
+	}
+
+	epochMintIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.EpochMints {
+		if _, ok := roleGrantIndexMap[elem.Denom+"/"+elem.Minter]; !ok {
+			return fmt.Errorf("epoch mint for %s on denom %s without a role grant", elem.Minter, elem.Denom)
+		}
+		if elem.Amount.IsNil() || elem.Amount.IsNegative() {
+			return fmt.Errorf("epoch mint for denom %s cannot be negative", elem.Denom)
+		}
+		index := elem.Denom + "/" + elem.Minter
+		if _, ok := epochMintIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for epoch mint")
+		}
+		epochMintIndexMap[index] = struct{}{}
+	}
+
+	ownershipProposalIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OwnershipProposals {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("ownership proposal for unknown denom %s", elem.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.ProposedOwner); err != nil {
+			return fmt.Errorf("invalid proposed owner %s: %w", elem.ProposedOwner, err)
+		}
+		if _, ok := ownershipProposalIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for ownership proposal")
+		}
+		ownershipProposalIndexMap[elem.Denom] = struct{}{}
+	}
+
+	beforeSendHookIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BeforeSendHooks {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("before send hook for unknown denom %s", elem.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.ContractAddress); err != nil {
+			return fmt.Errorf("invalid before send hook contract %s: %w", elem.ContractAddress, err)
+		}
+		if _, ok := beforeSendHookIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for before send hook")
+		}
+		beforeSendHookIndexMap[elem.Denom] = struct{}{}
+	}
+
+	supplyCheckpointIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.SupplyCheckpoints {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("supply checkpoint for unknown denom %s", elem.Denom)
+		}
+		if elem.Height < 0 {
+			return fmt.Errorf("supply checkpoint for denom %s has a negative height", elem.Denom)
+		}
+		if elem.Supply.IsNil() || elem.Supply.IsNegative() {
+			return fmt.Errorf("supply checkpoint for denom %s cannot be negative", elem.Denom)
+		}
+		index := fmt.Sprintf("%s/%d", elem.Denom, elem.Height)
+		if _, ok := supplyCheckpointIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for supply checkpoint")
+		}
+		supplyCheckpointIndexMap[index] = struct{}{}
+	}
+
+	feeDenomIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FeeDenoms {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("fee denom for unknown denom %s", elem.Denom)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if _, ok := feeDenomIndexMap[elem.Denom]; ok {
+			return fmt.Errorf("duplicated index for fee denom")
+		}
+		feeDenomIndexMap[elem.Denom] = struct{}{}
+	}
+
+	feeDenomPriceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FeeDenomPrices {
+		if _, ok := feeDenomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("fee denom price for unknown fee denom %s", elem.Denom)
+		}
+		if elem.Price.IsNil() || !elem.Price.IsPositive() {
+			return fmt.Errorf("fee denom price for denom %s must be positive", elem.Denom)
+		}
+		index := fmt.Sprintf("%s/%d", elem.Denom, elem.Time.UnixNano())
+		if _, ok := feeDenomPriceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for fee denom price")
+		}
+		feeDenomPriceIndexMap[index] = struct{}{}
+	}
+
+	distributionIndexMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.Distributions {
+		if _, ok := denomIndexMap[elem.Denom]; !ok {
+			return fmt.Errorf("distribution for unknown denom %s", elem.Denom)
+		}
+		if elem.Id >= gs.DistributionCount {
+			return fmt.Errorf("distribution id %d must be lower than the distribution count", elem.Id)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Creator); err != nil {
+			return fmt.Errorf("invalid distribution creator %s: %w", elem.Creator, err)
+		}
+		if !elem.Rewards.IsValid() || elem.Rewards.IsZero() {
+			return fmt.Errorf("distribution %d must have valid positive rewards", elem.Id)
+		}
+		if !elem.Remaining.IsValid() || !elem.Rewards.IsAllGTE(elem.Remaining) {
+			return fmt.Errorf("distribution %d has invalid remaining rewards", elem.Id)
+		}
+		if elem.Supply.IsNil() || !elem.Supply.IsPositive() {
+			return fmt.Errorf("distribution %d must have a positive supply", elem.Id)
+		}
+		if _, ok := distributionIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated index for distribution")
+		}
+		distributionIndexMap[elem.Id] = struct{}{}
+	}
+
+	distributionBalanceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DistributionBalances {
+		if _, ok := distributionIndexMap[elem.DistributionId]; !ok {
+			return fmt.Errorf("distribution balance for unknown distribution %d", elem.DistributionId)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid distribution balance address %s: %w", elem.Address, err)
+		}
+		if elem.Amount.IsNil() || elem.Amount.IsNegative() {
+			return fmt.Errorf("distribution balance for distribution %d cannot be negative", elem.DistributionId)
+		}
+		index := fmt.Sprintf("%d/%s", elem.DistributionId, elem.Address)
+		if _, ok := distributionBalanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for distribution balance")
+		}
+		distributionBalanceIndexMap[index] = struct{}{}
+	}
+
+	distributionClaimIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DistributionClaims {
+		if _, ok := distributionIndexMap[elem.DistributionId]; !ok {
+			return fmt.Errorf("distribution claim for unknown distribution %d", elem.DistributionId)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return fmt.Errorf("invalid distribution claim address %s: %w", elem.Address, err)
+		}
+		index := fmt.Sprintf("%d/%s", elem.DistributionId, elem.Address)
+		if _, ok := distributionClaimIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for distribution claim")
+		}
+		distributionClaimIndexMap[index] = struct{}{}
+	}
+
+	escrowAddressIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.EscrowAddresses {
+		if _, err := sdk.AccAddressFromBech32(elem); err != nil {
+			return fmt.Errorf("invalid escrow address %s: %w", elem, err)
+		}
+		if _, ok := escrowAddressIndexMap[elem]; ok {
+			return fmt.Errorf("duplicated index for escrow address")
+		}
+		escrowAddressIndexMap[elem] = struct{}{}
 	}
 
 	return gs.Params.Validate()
-}
\ No newline at end of file
+}
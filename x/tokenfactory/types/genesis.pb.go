@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,8 +28,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the tokenfactory module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params   Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	DenomMap []Denom `protobuf:"bytes,2,rep,name=denom_map,json=denomMap,proto3" json:"denom_map"`
+	Params               Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DenomMap             []Denom               `protobuf:"bytes,2,rep,name=denom_map,json=denomMap,proto3" json:"denom_map"`
+	BurnAllowances       []BurnAllowance       `protobuf:"bytes,3,rep,name=burn_allowances,json=burnAllowances,proto3" json:"burn_allowances"`
+	FrozenAccounts       []FrozenAccount       `protobuf:"bytes,4,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts"`
+	RoleGrants           []RoleGrant           `protobuf:"bytes,5,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	EpochMints           []EpochMint           `protobuf:"bytes,6,rep,name=epoch_mints,json=epochMints,proto3" json:"epoch_mints"`
+	OwnershipProposals   []OwnershipProposal   `protobuf:"bytes,7,rep,name=ownership_proposals,json=ownershipProposals,proto3" json:"ownership_proposals"`
+	BeforeSendHooks      []BeforeSendHook      `protobuf:"bytes,8,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks"`
+	SupplyCheckpoints    []SupplyCheckpoint    `protobuf:"bytes,9,rep,name=supply_checkpoints,json=supplyCheckpoints,proto3" json:"supply_checkpoints"`
+	FeeDenoms            []FeeDenom            `protobuf:"bytes,10,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	FeeDenomPrices       []FeeDenomPrice       `protobuf:"bytes,11,rep,name=fee_denom_prices,json=feeDenomPrices,proto3" json:"fee_denom_prices"`
+	Distributions        []Distribution        `protobuf:"bytes,12,rep,name=distributions,proto3" json:"distributions"`
+	DistributionBalances []DistributionBalance `protobuf:"bytes,13,rep,name=distribution_balances,json=distributionBalances,proto3" json:"distribution_balances"`
+	DistributionClaims   []DistributionClaim   `protobuf:"bytes,14,rep,name=distribution_claims,json=distributionClaims,proto3" json:"distribution_claims"`
+	// distribution_count is the id of the next distribution.
+	DistributionCount uint64 `protobuf:"varint,15,opt,name=distribution_count,json=distributionCount,proto3" json:"distribution_count,omitempty"`
+	// escrow_addresses are the ICS-20 escrow accounts of the transfer channels
+	// and clients, which are exempt from transfer fees.
+	EscrowAddresses []string `protobuf:"bytes,16,rep,name=escrow_addresses,json=escrowAddresses,proto3" json:"escrow_addresses,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +96,104 @@ func (m *GenesisState) GetDenomMap() []Denom {
 	return nil
 }
 
+func (m *GenesisState) GetBurnAllowances() []BurnAllowance {
+	if m != nil {
+		return m.BurnAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochMints() []EpochMint {
+	if m != nil {
+		return m.EpochMints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOwnershipProposals() []OwnershipProposal {
+	if m != nil {
+		return m.OwnershipProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBeforeSendHooks() []BeforeSendHook {
+	if m != nil {
+		return m.BeforeSendHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyCheckpoints() []SupplyCheckpoint {
+	if m != nil {
+		return m.SupplyCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeDenomPrices() []FeeDenomPrice {
+	if m != nil {
+		return m.FeeDenomPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributions() []Distribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionBalances() []DistributionBalance {
+	if m != nil {
+		return m.DistributionBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionClaims() []DistributionClaim {
+	if m != nil {
+		return m.DistributionClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionCount() uint64 {
+	if m != nil {
+		return m.DistributionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEscrowAddresses() []string {
+	if m != nil {
+		return m.EscrowAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nimochain.tokenfactory.v1.GenesisState")
 }
@@ -87,23 +203,54 @@ func init() {
 }
 
 var fileDescriptor_8dddb57e28ad7c75 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcf, 0xcb, 0xcc, 0xcd,
-	0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9,
-	0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x2b, 0xd4, 0x43, 0x56, 0xa8, 0x57, 0x66, 0x28, 0x25, 0x98,
-	0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xaa, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1,
-	0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0xaa, 0x86, 0xdb, 0xb2, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x5d,
-	0x52, 0xaa, 0xb8, 0xd5, 0xa5, 0xa4, 0xe6, 0xe5, 0xe7, 0x42, 0x94, 0x29, 0xcd, 0x64, 0xe4, 0xe2,
-	0x71, 0x87, 0x38, 0x32, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x85, 0x8b, 0x0d, 0x62, 0x8e, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa2, 0x1e, 0x4e, 0x47, 0xeb, 0x05, 0x80, 0x15, 0x3a, 0x71,
-	0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x5e, 0x21, 0x67, 0x2e,
-	0x4e, 0xb0, 0x2d, 0xf1, 0xb9, 0x89, 0x05, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x0a, 0x78,
-	0x0c, 0x72, 0x01, 0xa9, 0x75, 0x62, 0x01, 0x99, 0x13, 0xc4, 0x01, 0xd6, 0xe8, 0x9b, 0x58, 0xe0,
-	0x64, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xf2, 0x20, 0xa3, 0x74,
-	0x21, 0xbe, 0xab, 0x40, 0xf5, 0x5f, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x77, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x3c, 0x31, 0xb1, 0x9b, 0x01, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xc1, 0x52, 0x13, 0x4b,
+	0x14, 0x86, 0x93, 0x0b, 0x97, 0x4b, 0x3a, 0x40, 0x48, 0x5f, 0x6e, 0xd5, 0xc0, 0x22, 0xe4, 0x2a,
+	0x4a, 0x50, 0x48, 0x04, 0x57, 0x2e, 0x93, 0xa0, 0x50, 0x65, 0x51, 0x52, 0xc9, 0x42, 0x4b, 0x17,
+	0x63, 0x67, 0xd2, 0x49, 0xba, 0x32, 0xd3, 0x3d, 0xd5, 0x67, 0x02, 0xe2, 0x53, 0xf8, 0x18, 0x2e,
+	0x5d, 0xf8, 0x0c, 0x16, 0x4b, 0xca, 0x95, 0x2b, 0xcb, 0x82, 0x85, 0xaf, 0x61, 0x75, 0x4f, 0x4f,
+	0x9c, 0x09, 0x95, 0x89, 0x1b, 0x8a, 0x39, 0xe7, 0xff, 0xbf, 0xee, 0x99, 0xfe, 0x4f, 0x07, 0x6d,
+	0x73, 0xe6, 0x09, 0x67, 0x40, 0x18, 0xaf, 0x05, 0x62, 0x48, 0x79, 0x8f, 0x38, 0x81, 0x90, 0x17,
+	0xb5, 0xb3, 0xfd, 0x5a, 0x9f, 0x72, 0x0a, 0x0c, 0xaa, 0xbe, 0x14, 0x81, 0xc0, 0xeb, 0x63, 0x61,
+	0x35, 0x2e, 0xac, 0x9e, 0xed, 0x6f, 0x14, 0x89, 0xc7, 0xb8, 0xa8, 0xe9, 0xbf, 0xa1, 0x7a, 0x63,
+	0xdd, 0x11, 0xe0, 0x09, 0xb0, 0xf5, 0x53, 0x2d, 0x7c, 0x30, 0xad, 0xb5, 0xbe, 0xe8, 0x8b, 0xb0,
+	0xae, 0xfe, 0x33, 0xd5, 0xfb, 0xd3, 0xf7, 0xe1, 0x13, 0x49, 0xbc, 0xc8, 0x7d, 0x6f, 0xba, 0xae,
+	0x4b, 0xb9, 0xf0, 0x8c, 0xac, 0x3a, 0x5d, 0xd6, 0x19, 0x49, 0x6e, 0x13, 0xd7, 0x15, 0xe7, 0x84,
+	0x3b, 0x74, 0xb6, 0xbe, 0x27, 0xc5, 0x7b, 0xca, 0x6d, 0xe2, 0x38, 0x62, 0xc4, 0x03, 0xa3, 0xdf,
+	0x9a, 0xae, 0x97, 0xc2, 0x8d, 0xa8, 0x07, 0xd3, 0x55, 0xe2, 0x9c, 0x53, 0x09, 0x03, 0xe6, 0xab,
+	0x4f, 0xe4, 0x0b, 0x20, 0xae, 0xf1, 0x3c, 0x4a, 0xd9, 0x39, 0xed, 0x09, 0x49, 0x6d, 0xa0, 0xbc,
+	0x6b, 0x0f, 0x84, 0x18, 0x1a, 0xc7, 0xfe, 0x74, 0x07, 0x8c, 0x7c, 0xdf, 0xbd, 0xb0, 0x9d, 0x01,
+	0x75, 0x86, 0xbe, 0x60, 0xe3, 0xed, 0xef, 0xa4, 0xbc, 0x2e, 0xa5, 0x76, 0xfc, 0x4b, 0xee, 0xa6,
+	0x7c, 0x70, 0x06, 0x81, 0x64, 0x9d, 0x51, 0xc0, 0x04, 0x0f, 0xd5, 0x77, 0xbe, 0x20, 0xb4, 0x74,
+	0x14, 0xe6, 0xa6, 0x1d, 0x90, 0x80, 0xe2, 0x43, 0xb4, 0x10, 0x9e, 0x9f, 0x95, 0x2d, 0x67, 0x2b,
+	0xf9, 0x83, 0xff, 0xab, 0x53, 0x73, 0x54, 0x3d, 0xd5, 0xc2, 0x46, 0xee, 0xf2, 0xfb, 0x66, 0xe6,
+	0xe3, 0xcf, 0x4f, 0x0f, 0xb2, 0x2d, 0xe3, 0xc5, 0x4d, 0x94, 0xd3, 0x7b, 0xb2, 0x3d, 0xe2, 0x5b,
+	0x7f, 0x95, 0xe7, 0x2a, 0xf9, 0x83, 0x72, 0x0a, 0xe8, 0x50, 0x69, 0x1b, 0xf3, 0x8a, 0xd3, 0x5a,
+	0xd4, 0xc6, 0x13, 0xe2, 0xe3, 0x97, 0xa8, 0x90, 0x3c, 0x7b, 0xb0, 0xe6, 0x34, 0xaa, 0x92, 0x82,
+	0x6a, 0x8c, 0x24, 0xaf, 0x47, 0x06, 0x83, 0x5c, 0xe9, 0xc4, 0x8b, 0xa0, 0xc0, 0xc9, 0x90, 0x80,
+	0x35, 0x3f, 0x13, 0xfc, 0x4c, 0x3b, 0xea, 0xa1, 0x21, 0x02, 0xf7, 0xe2, 0x45, 0xc0, 0xcf, 0x51,
+	0x5e, 0xa5, 0xc9, 0xee, 0x4b, 0xa2, 0xa0, 0x7f, 0x6b, 0xe8, 0x56, 0x0a, 0xb4, 0x25, 0x5c, 0x7a,
+	0xa4, 0xc4, 0x06, 0x88, 0x64, 0x54, 0xd0, 0x30, 0xea, 0x0b, 0x67, 0x60, 0x7b, 0x4c, 0xc1, 0x16,
+	0x66, 0xc2, 0x9e, 0x2a, 0xf5, 0x09, 0xfb, 0x0d, 0xa3, 0x51, 0x01, 0xb0, 0x83, 0xfe, 0xbd, 0x9d,
+	0x60, 0xb0, 0xfe, 0xd1, 0xd0, 0xdd, 0x14, 0xe8, 0x8b, 0xc8, 0x75, 0x6a, 0x4c, 0x06, 0x8e, 0xc5,
+	0x64, 0x03, 0xf0, 0x1b, 0x54, 0x9c, 0x8c, 0x3c, 0x58, 0x8b, 0x7a, 0x89, 0x9d, 0xb4, 0x23, 0xd3,
+	0x9e, 0x36, 0xe5, 0xdd, 0x63, 0x21, 0x86, 0x86, 0x5f, 0xe8, 0x24, 0xaa, 0x80, 0xdf, 0x22, 0x7c,
+	0x6b, 0x3a, 0xc0, 0xca, 0x69, 0xfa, 0xc3, 0x14, 0x7a, 0x5b, 0x9b, 0x9a, 0x63, 0x8f, 0xe1, 0x17,
+	0x61, 0xa2, 0x0e, 0xf8, 0x18, 0xa1, 0xf1, 0x30, 0x81, 0x85, 0x34, 0xf9, 0x6e, 0x5a, 0x22, 0x28,
+	0x8d, 0x07, 0x37, 0xd7, 0x33, 0xcf, 0x80, 0x5f, 0xa1, 0xd5, 0x31, 0xc9, 0xf6, 0x25, 0x53, 0xd1,
+	0xcd, 0xcf, 0x4e, 0x98, 0xf1, 0x9f, 0x2a, 0xc3, 0x38, 0x61, 0xf1, 0x22, 0xe0, 0x36, 0x5a, 0x8e,
+	0x4f, 0x31, 0x58, 0x4b, 0x1a, 0xbb, 0x9d, 0x36, 0x5c, 0x31, 0xbd, 0xa1, 0x26, 0x19, 0x98, 0xa1,
+	0xff, 0xe2, 0x05, 0xbb, 0x43, 0xdc, 0x70, 0xdc, 0x96, 0x35, 0xbc, 0xfa, 0xa7, 0xf0, 0xd0, 0x66,
+	0xd6, 0x58, 0xeb, 0xde, 0x6e, 0xe9, 0x1c, 0x26, 0x96, 0x72, 0x5c, 0xc2, 0x3c, 0xb0, 0x56, 0x66,
+	0xe6, 0x30, 0xbe, 0x50, 0x53, 0x99, 0xa2, 0x1c, 0x76, 0x27, 0x1b, 0x80, 0xf7, 0x10, 0x4e, 0x2e,
+	0xa2, 0xa6, 0xd3, 0x2a, 0x94, 0xb3, 0x95, 0xf9, 0x56, 0x31, 0xa1, 0x57, 0x0d, 0xdc, 0x44, 0xab,
+	0x14, 0x1c, 0x29, 0xce, 0x6d, 0xd2, 0xed, 0x4a, 0x0a, 0x40, 0xc1, 0x5a, 0x2d, 0xcf, 0x55, 0x72,
+	0x0d, 0xeb, 0xeb, 0xe7, 0xbd, 0x35, 0xf3, 0x63, 0x58, 0x0f, 0x7b, 0xed, 0x40, 0x32, 0xde, 0x6f,
+	0x15, 0x42, 0x47, 0x3d, 0x32, 0x34, 0x9e, 0x5c, 0x5e, 0x97, 0xb2, 0x57, 0xd7, 0xa5, 0xec, 0x8f,
+	0xeb, 0x52, 0xf6, 0xc3, 0x4d, 0x29, 0x73, 0x75, 0x53, 0xca, 0x7c, 0xbb, 0x29, 0x65, 0x5e, 0x6f,
+	0xaa, 0x97, 0xda, 0x0b, 0x6f, 0xe4, 0x77, 0xc9, 0x3b, 0x39, 0xb8, 0xf0, 0x29, 0x74, 0x16, 0xf4,
+	0x55, 0xfc, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x8a, 0xbe, 0x04, 0xdb, 0x07, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +273,190 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EscrowAddresses) > 0 {
+		for iNdEx := len(m.EscrowAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EscrowAddresses[iNdEx])
+			copy(dAtA[i:], m.EscrowAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EscrowAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.DistributionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionCount))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.DistributionClaims) > 0 {
+		for iNdEx := len(m.DistributionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DistributionBalances) > 0 {
+		for iNdEx := len(m.DistributionBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FeeDenomPrices) > 0 {
+		for iNdEx := len(m.FeeDenomPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SupplyCheckpoints) > 0 {
+		for iNdEx := len(m.SupplyCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for iNdEx := len(m.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeforeSendHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OwnershipProposals) > 0 {
+		for iNdEx := len(m.OwnershipProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnershipProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EpochMints) > 0 {
+		for iNdEx := len(m.EpochMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BurnAllowances) > 0 {
+		for iNdEx := len(m.BurnAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DenomMap) > 0 {
 		for iNdEx := len(m.DenomMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +509,87 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BurnAllowances) > 0 {
+		for _, e := range m.BurnAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochMints) > 0 {
+		for _, e := range m.EpochMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnershipProposals) > 0 {
+		for _, e := range m.OwnershipProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for _, e := range m.BeforeSendHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyCheckpoints) > 0 {
+		for _, e := range m.SupplyCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeDenomPrices) > 0 {
+		for _, e := range m.FeeDenomPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionBalances) > 0 {
+		for _, e := range m.DistributionBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionClaims) > 0 {
+		for _, e := range m.DistributionClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DistributionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionCount))
+	}
+	if len(m.EscrowAddresses) > 0 {
+		for _, s := range m.EscrowAddresses {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +695,465 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnAllowances = append(m.BurnAllowances, BurnAllowance{})
+			if err := m.BurnAllowances[len(m.BurnAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochMints = append(m.EpochMints, EpochMint{})
+			if err := m.EpochMints[len(m.EpochMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipProposals = append(m.OwnershipProposals, OwnershipProposal{})
+			if err := m.OwnershipProposals[len(m.OwnershipProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHooks = append(m.BeforeSendHooks, BeforeSendHook{})
+			if err := m.BeforeSendHooks[len(m.BeforeSendHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyCheckpoints = append(m.SupplyCheckpoints, SupplyCheckpoint{})
+			if err := m.SupplyCheckpoints[len(m.SupplyCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomPrices = append(m.FeeDenomPrices, FeeDenomPrice{})
+			if err := m.FeeDenomPrices[len(m.FeeDenomPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionBalances = append(m.DistributionBalances, DistributionBalance{})
+			if err := m.DistributionBalances[len(m.DistributionBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionClaims = append(m.DistributionClaims, DistributionClaim{})
+			if err := m.DistributionClaims[len(m.DistributionClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCount", wireType)
+			}
+			m.DistributionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddresses = append(m.EscrowAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
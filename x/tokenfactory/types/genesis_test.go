@@ -1,58 +1,235 @@
 package types_test
 
-// func TestGenesisState_Validate(t *testing.T) {
-// 	tests := []struct {
-// 		desc     string
-// 		genState *types.GenesisState
-// 		valid    bool
-// 	}{
-// 		{
-// 			desc:     "default is valid",
-// 			genState: types.DefaultGenesis(),
-// 			valid:    true,
-// 		},
-// 		{
-// 			desc: "valid genesis state",
-// 			genState: &types.GenesisState{
+import (
+	"testing"
+	"time"
 
-// 				// DenomMap: []types.Denom{{Denom: "0"}, {Denom: "1"}}, DenomMap: []types.Denom{{Denom: "0"}, {Denom: "1"}}},
-// 			valid: true,
-// 		}, {
-// 			desc: "duplicated denom",
-// 			genState: &types.GenesisState{
-// 				DenomMap: []types.Denom{
-// 					{
-// 						Denom: "0",
-// 					},
-// 					{
-// 						Denom: "0",
-// 					},
-// 				},
-// 				DenomMap: []types.Denom{{Denom: "0"}, {Denom: "1"}}},
-// 			valid: false,
-// 		}, {
-// 			desc: "duplicated denom",
-// 			genState: &types.GenesisState{
-// 				DenomMap: []types.Denom{
-// 					{
-// 						Denom: "0",
-// 					},
-// 					{
-// 						Denom: "0",
-// 					},
-// 				},
-// 			},
-// 			valid: false,
-// 		},
-// 	}
-// 	for _, tc := range tests {
-// 		t.Run(tc.desc, func(t *testing.T) {
-// 			err := tc.genState.Validate()
-// 			if tc.valid {
-// 				require.NoError(t, err)
-// 			} else {
-// 				require.Error(t, err)
-// 			}
-// 		})
-// 	}
-// }
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/testutil/sample"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	creator := sample.AccAddress()
+	denom0 := "factory/" + creator + "/denom0"
+	denom1 := "factory/" + creator + "/denom1"
+	allowance := math.NewInt(1)
+
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				DenomMap:   []types.Denom{{Denom: denom0}, {Denom: denom1}},
+				RoleGrants: []types.RoleGrant{{Denom: denom0, Address: creator, Roles: types.AllRoles, MintAllowance: &allowance}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated denom",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: denom0}, {Denom: denom0}},
+			},
+			valid: false,
+		},
+		{
+			desc: "free-form denom",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: "uatom"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "bond denom as subdenom",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: "factory/" + creator + "/" + sdk.DefaultBondDenom}},
+			},
+			valid: false,
+		},
+		{
+			desc: "reserved prefix as subdenom",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: "factory/" + creator + "/ibc"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid creator",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: "factory/creator/denom0"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "burn allowance for unknown denom",
+			genState: &types.GenesisState{
+				DenomMap:       []types.Denom{{Denom: denom0}},
+				BurnAllowances: []types.BurnAllowance{{Denom: denom1, Holder: creator, Amount: math.NewInt(1)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "frozen account for unknown denom",
+			genState: &types.GenesisState{
+				DenomMap:       []types.Denom{{Denom: denom0}},
+				FrozenAccounts: []types.FrozenAccount{{Denom: denom1, Address: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated frozen account",
+			genState: &types.GenesisState{
+				DenomMap:       []types.Denom{{Denom: denom0}},
+				FrozenAccounts: []types.FrozenAccount{{Denom: denom0, Address: creator}, {Denom: denom0, Address: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "role grant with invalid role",
+			genState: &types.GenesisState{
+				DenomMap:   []types.Denom{{Denom: denom0}},
+				RoleGrants: []types.RoleGrant{{Denom: denom0, Address: creator, Roles: []types.Role{types.ROLE_UNSPECIFIED}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "mint allowance without minter role",
+			genState: &types.GenesisState{
+				DenomMap:   []types.Denom{{Denom: denom0}},
+				RoleGrants: []types.RoleGrant{{Denom: denom0, Address: creator, Roles: []types.Role{types.ROLE_PAUSER}, MintAllowance: &allowance}},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook for unknown denom",
+			genState: &types.GenesisState{
+				DenomMap:        []types.Denom{{Denom: denom0}},
+				BeforeSendHooks: []types.BeforeSendHook{{Denom: denom1, ContractAddress: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "clawback role on denom without clawback",
+			genState: &types.GenesisState{
+				DenomMap:   []types.Denom{{Denom: denom0}},
+				RoleGrants: []types.RoleGrant{{Denom: denom0, Address: creator, Roles: []types.Role{types.ROLE_CLAWBACK}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer fee without treasury",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: denom0, TransferFee: &types.TransferFee{BasisPoints: 100}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated supply checkpoint",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: denom0}},
+				SupplyCheckpoints: []types.SupplyCheckpoint{
+					{Denom: denom0, Height: 10, Supply: math.NewInt(1)},
+					{Denom: denom0, Height: 10, Supply: math.NewInt(2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "fee denom with oracle prices",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				DenomMap:       []types.Denom{{Denom: denom0}},
+				FeeDenoms:      []types.FeeDenom{{Denom: denom0, Oracles: []string{creator}, TwapWindow: 60}},
+				FeeDenomPrices: []types.FeeDenomPrice{{Denom: denom0, Time: time.Unix(1, 0), Price: math.LegacyOneDec()}},
+			},
+			valid: true,
+		},
+		{
+			desc: "fee denom without rate source",
+			genState: &types.GenesisState{
+				DenomMap:  []types.Denom{{Denom: denom0}},
+				FeeDenoms: []types.FeeDenom{{Denom: denom0}},
+			},
+			valid: false,
+		},
+		{
+			desc: "fee denom price for unknown fee denom",
+			genState: &types.GenesisState{
+				DenomMap:       []types.Denom{{Denom: denom0}},
+				FeeDenomPrices: []types.FeeDenomPrice{{Denom: denom0, Time: time.Unix(1, 0), Price: math.LegacyOneDec()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "distribution with balances and claims",
+			genState: &types.GenesisState{
+				Params:   types.DefaultParams(),
+				DenomMap: []types.Denom{{Denom: denom0}},
+				Distributions: []types.Distribution{{
+					Id:        0,
+					Denom:     denom0,
+					Creator:   creator,
+					Rewards:   sdk.NewCoins(sdk.NewInt64Coin("unimo", 10)),
+					Remaining: sdk.NewCoins(sdk.NewInt64Coin("unimo", 5)),
+					Supply:    math.NewInt(100),
+				}},
+				DistributionBalances: []types.DistributionBalance{{DistributionId: 0, Address: creator, Amount: math.NewInt(50)}},
+				DistributionClaims:   []types.DistributionClaim{{DistributionId: 0, Address: creator}},
+				DistributionCount:    1,
+			},
+			valid: true,
+		},
+		{
+			desc: "distribution id not below the count",
+			genState: &types.GenesisState{
+				DenomMap: []types.Denom{{Denom: denom0}},
+				Distributions: []types.Distribution{{
+					Id:      0,
+					Denom:   denom0,
+					Creator: creator,
+					Rewards: sdk.NewCoins(sdk.NewInt64Coin("unimo", 10)),
+					Supply:  math.NewInt(100),
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated escrow address",
+			genState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				EscrowAddresses: []string{creator, creator},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed hook code id",
+			genState: &types.GenesisState{
+				Params: types.NewParams("day", nil, false, 0, 1, 1, 18, true, []uint64{1, 1}, 1, "day", 0, 1, 1, 1),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// BeforeSendHookKey is the prefix to retrieve all BeforeSendHook
+var BeforeSendHookKey = collections.NewPrefix("beforesendhook/value/")
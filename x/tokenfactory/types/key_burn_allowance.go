@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// BurnAllowanceKey is the prefix to retrieve all BurnAllowance
+var BurnAllowanceKey = collections.NewPrefix("burnallowance/value/")
@@ -3,4 +3,7 @@ package types
 import "cosmossdk.io/collections"
 
 // DenomKey is the prefix to retrieve all Denom
-var DenomKey = collections.NewPrefix("denom/value/")
\ No newline at end of file
+var DenomKey = collections.NewPrefix("denom/value/")
+
+// DenomOwnerIndexKey is the prefix of the index of Denom by owner
+var DenomOwnerIndexKey = collections.NewPrefix("denom/owner/")
@@ -0,0 +1,18 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// DistributionKey is the prefix to retrieve all Distribution
+	DistributionKey = collections.NewPrefix("distribution/value/")
+	// DistributionSeqKey is the prefix of the next distribution id
+	DistributionSeqKey = collections.NewPrefix("distribution/seq/")
+	// ActiveDistributionKey is the prefix of the distributions still claimable by denom
+	ActiveDistributionKey = collections.NewPrefix("distribution/active/")
+	// DistributionExpiryQueueKey is the prefix of the distributions ordered by expiry
+	DistributionExpiryQueueKey = collections.NewPrefix("distribution/expiry/")
+	// DistributionBalanceKey is the prefix to retrieve all DistributionBalance
+	DistributionBalanceKey = collections.NewPrefix("distribution/balance/")
+	// DistributionClaimKey is the prefix to retrieve all DistributionClaim
+	DistributionClaimKey = collections.NewPrefix("distribution/claim/")
+)
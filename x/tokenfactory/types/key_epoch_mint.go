@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// EpochMintKey is the prefix to retrieve all EpochMint
+var EpochMintKey = collections.NewPrefix("epochmint/value/")
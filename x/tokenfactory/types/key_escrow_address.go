@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// EscrowAddressKey is the prefix to retrieve all EscrowAddress
+var EscrowAddressKey = collections.NewPrefix("escrowaddress/value/")
@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// FeeDenomKey is the prefix to retrieve all FeeDenom
+var FeeDenomKey = collections.NewPrefix("feedenom/value/")
+
+// FeeDenomPriceKey is the prefix to retrieve all FeeDenomPrice
+var FeeDenomPriceKey = collections.NewPrefix("feedenomprice/value/")
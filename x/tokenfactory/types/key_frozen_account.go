@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// FrozenAccountKey is the prefix to retrieve all FrozenAccount
+var FrozenAccountKey = collections.NewPrefix("frozenaccount/value/")
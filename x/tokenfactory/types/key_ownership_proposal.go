@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	// OwnershipProposalKey is the prefix to retrieve all OwnershipProposal
+	OwnershipProposalKey = collections.NewPrefix("ownershipproposal/value/")
+
+	// OwnershipExpiryQueueKey is the prefix of the (expiry, denom) queue used
+	// to prune expired ownership proposals
+	OwnershipExpiryQueueKey = collections.NewPrefix("ownershipproposal/expiry/")
+)
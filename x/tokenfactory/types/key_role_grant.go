@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// RoleGrantKey is the prefix to retrieve all RoleGrant
+var RoleGrantKey = collections.NewPrefix("rolegrant/value/")
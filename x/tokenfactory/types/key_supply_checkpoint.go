@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// SupplyCheckpointKey is the prefix to retrieve all SupplyCheckpoint
+var SupplyCheckpointKey = collections.NewPrefix("supplycheckpoint/value/")
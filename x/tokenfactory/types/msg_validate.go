@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -22,11 +23,19 @@ func (msg *MsgCreateDenom) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid owner address: %s", err))
 	}
 	
-	if msg.Denom == "" {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	if err := ValidateSubdenom(msg.Subdenom); err != nil {
+		return err
+	}
+
+	if msg.Precision < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "precision cannot be negative")
+	}
+
+	if err := ValidateDisplayUnit(msg.Subdenom, msg.Ticker, msg.Precision); err != nil {
+		return err
 	}
 	
-	if msg.MaxSupply <= 0 {
+	if msg.MaxSupply.IsNil() || !msg.MaxSupply.IsPositive() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max supply must be positive")
 	}
 	
@@ -51,7 +60,8 @@ func (msg *MsgUpdateDenom) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
 	}
 	
-	if msg.MaxSupply <= 0 {
+	// An unset max supply keeps the current one
+	if !msg.MaxSupply.IsNil() && !msg.MaxSupply.IsPositive() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max supply must be positive")
 	}
 	
@@ -105,13 +115,106 @@ func (msg *MsgMintAndSendTokens) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
 	}
 	
-	if msg.Amount <= 0 {
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
 	}
 	
 	return nil
 }
 
+// ValidateBasic performs basic validation for MsgMultiMint
+func (msg *MsgMultiMint) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if len(msg.Outputs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "outputs cannot be empty")
+	}
+
+	for _, output := range msg.Outputs {
+		if _, err := sdk.AccAddressFromBech32(output.Recipient); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+		}
+
+		if output.Amount.IsNil() || !output.Amount.IsPositive() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgMintVested
+func (msg *MsgMintVested) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	if msg.StartTime < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "start time cannot be negative")
+	}
+
+	// A continuous schedule only needs an end time
+	if len(msg.Periods) == 0 {
+		if msg.StartTime != 0 && msg.EndTime <= msg.StartTime {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "end time must be after start time")
+		}
+		if msg.EndTime <= 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "end time must be positive")
+		}
+		return nil
+	}
+
+	if msg.EndTime != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "end time is derived from the periods")
+	}
+
+	total := math.ZeroInt()
+	for i, period := range msg.Periods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "length of period %d must be positive", i)
+		}
+		if period.Amount.IsNil() || !period.Amount.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount of period %d must be positive", i)
+		}
+		var err error
+		if total, err = total.SafeAdd(period.Amount); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("period amounts overflow: %s", err))
+		}
+	}
+
+	if !total.Equal(msg.Amount) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "period amounts must add up to amount")
+	}
+
+	return nil
+}
+
 // ValidateBasic performs basic validation for MsgUpdateOwner
 func (msg *MsgUpdateOwner) ValidateBasic() error {
 	if msg == nil {
@@ -141,6 +244,283 @@ func (msg *MsgUpdateOwner) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic performs basic validation for MsgBurn
+func (msg *MsgBurn) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if msg.Creator == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "creator cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgBurnFrom
+func (msg *MsgBurnFrom) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if msg.Creator == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "creator cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if msg.Holder == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "holder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid holder address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgApproveBurn
+func (msg *MsgApproveBurn) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if msg.Holder == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "holder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid holder address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount cannot be negative")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgFreezeAccount
+func (msg *MsgFreezeAccount) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validateFreezeFields(msg.Creator, msg.Denom, msg.Address)
+}
+
+// ValidateBasic performs basic validation for MsgUnfreezeAccount
+func (msg *MsgUnfreezeAccount) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validateFreezeFields(msg.Creator, msg.Denom, msg.Address)
+}
+
+// validateFreezeFields checks the fields shared by the freeze messages.
+func validateFreezeFields(creator, denom, address string) error {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgPauseDenom
+func (msg *MsgPauseDenom) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validatePauseFields(msg.Creator, msg.Denom)
+}
+
+// ValidateBasic performs basic validation for MsgUnpauseDenom
+func (msg *MsgUnpauseDenom) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validatePauseFields(msg.Creator, msg.Denom)
+}
+
+// validatePauseFields checks the fields shared by the pause messages.
+func validatePauseFields(creator, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgGrantRole
+func (msg *MsgGrantRole) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if err := validateRoleFields(msg.Creator, msg.Denom, msg.Address, msg.Role); err != nil {
+		return err
+	}
+
+	return validateMintLimit([]Role{msg.Role}, msg.MintAllowance)
+}
+
+// ValidateBasic performs basic validation for MsgRevokeRole
+func (msg *MsgRevokeRole) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validateRoleFields(msg.Creator, msg.Denom, msg.Address, msg.Role)
+}
+
+// validateRoleFields checks the fields shared by the role messages.
+func validateRoleFields(creator, denom, address string, role Role) error {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if !role.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid role %s", role))
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSetMinterAllowance
+func (msg *MsgSetMinterAllowance) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if err := validateRoleFields(msg.Creator, msg.Denom, msg.Minter, ROLE_MINTER); err != nil {
+		return err
+	}
+
+	if err := validateMintLimit([]Role{ROLE_MINTER}, msg.TotalAllowance); err != nil {
+		return err
+	}
+
+	return validateMintLimit([]Role{ROLE_MINTER}, msg.PerEpochLimit)
+}
+
+// ValidateBasic performs basic validation for MsgProposeOwner
+func (msg *MsgProposeOwner) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new owner address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgAcceptOwnership
+func (msg *MsgAcceptOwnership) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validatePauseFields(msg.NewOwner, msg.Denom)
+}
+
+// ValidateBasic performs basic validation for MsgCancelOwnershipProposal
+func (msg *MsgCancelOwnershipProposal) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validatePauseFields(msg.Creator, msg.Denom)
+}
+
+// ValidateBasic performs basic validation for MsgRenounceOwnership
+func (msg *MsgRenounceOwnership) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	return validatePauseFields(msg.Creator, msg.Denom)
+}
+
+// ValidateBasic performs basic validation for MsgSetBeforeSendHook
+func (msg *MsgSetBeforeSendHook) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if err := validatePauseFields(msg.Creator, msg.Denom); err != nil {
+		return err
+	}
+
+	// An empty contract address removes the hook
+	if msg.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid contract address: %s", err))
+		}
+	}
+
+	return nil
+}
+
 // ValidateBasic performs basic validation for MsgUpdateParams
 func (msg *MsgUpdateParams) ValidateBasic() error {
 	if msg == nil {
@@ -156,4 +536,147 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	
 	return msg.Params.Validate()
-}
\ No newline at end of file
+}
+// ValidateBasic performs basic validation for MsgForceTransfer
+func (msg *MsgForceTransfer) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid from address: %s", err))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.To); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid to address: %s", err))
+	}
+
+	if msg.From == msg.To {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "from and to cannot be the same address")
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSetTransferFee
+func (msg *MsgSetTransferFee) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if err := validatePauseFields(msg.Creator, msg.Denom); err != nil {
+		return err
+	}
+
+	// Zero basis points removes the fee
+	if msg.BasisPoints == 0 {
+		if msg.Treasury != "" || len(msg.ExemptAddresses) > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "removing the transfer fee takes no treasury or exempt addresses")
+		}
+		return nil
+	}
+
+	fee := TransferFee{BasisPoints: msg.BasisPoints, Treasury: msg.Treasury, ExemptAddresses: msg.ExemptAddresses}
+	if err := fee.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSetFeeDenom
+func (msg *MsgSetFeeDenom) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid authority address: %s", err))
+	}
+
+	if err := msg.FeeDenom.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgRemoveFeeDenom
+func (msg *MsgRemoveFeeDenom) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid authority address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgSubmitFeeDenomPrice
+func (msg *MsgSubmitFeeDenomPrice) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Oracle); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid oracle address: %s", err))
+	}
+
+	if msg.Denom == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "denom cannot be empty")
+	}
+
+	if msg.Price.IsNil() || !msg.Price.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgDistributeToHolders
+func (msg *MsgDistributeToHolders) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if err := validatePauseFields(msg.Creator, msg.Denom); err != nil {
+		return err
+	}
+
+	if !msg.RewardCoins.IsValid() || msg.RewardCoins.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "reward coins must be valid and positive")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation for MsgClaimDistribution
+func (msg *MsgClaimDistribution) ValidateBasic() error {
+	if msg == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Claimer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid claimer address: %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"nimo-chain/testutil/sample"
+	"nimo-chain/x/tokenfactory/types"
+)
+
+func TestMsgCreateDenomValidateBasic(t *testing.T) {
+	owner := sample.AccAddress()
+
+	tests := []struct {
+		desc      string
+		subdenom  string
+		ticker    string
+		precision int64
+		valid     bool
+	}{
+		{desc: "ticker", subdenom: "token", ticker: "TKN", precision: 6, valid: true},
+		{desc: "subdenom as display unit", subdenom: "token", precision: 6, valid: true},
+		{desc: "short subdenom without precision", subdenom: "ab", valid: true},
+		{desc: "short ticker", subdenom: "token", ticker: "X", precision: 6, valid: false},
+		{desc: "short ticker without precision", subdenom: "token", ticker: "XY", valid: false},
+		{desc: "ticker with invalid characters", subdenom: "token", ticker: "T$K", precision: 6, valid: false},
+		{desc: "ticker too long", subdenom: "token", ticker: "T" + strings.Repeat("K", 128), precision: 6, valid: false},
+		{desc: "short subdenom as display unit", subdenom: "ab", precision: 6, valid: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgCreateDenom{
+				Owner:     owner,
+				Subdenom:  tc.subdenom,
+				Ticker:    tc.ticker,
+				Precision: tc.precision,
+				MaxSupply: math.NewInt(1000),
+			}
+			err := msg.ValidateBasic()
+			if !tc.valid {
+				require.ErrorIs(t, err, types.ErrInvalidMetadata)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
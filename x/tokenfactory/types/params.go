@@ -1,24 +1,191 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultMintEpochIdentifier resets per-epoch mint counters daily.
+	DefaultMintEpochIdentifier = "day"
+	// DefaultMaxDescriptionLength caps denom descriptions at 1024 bytes.
+	DefaultMaxDescriptionLength = 1024
+	// DefaultMaxURLLength caps denom urls at 256 bytes.
+	DefaultMaxURLLength = 256
+	// DefaultMaxPrecision matches the 18 decimals of ERC-20 tokens.
+	DefaultMaxPrecision = 18
+	// DefaultMaxMultiMintRecipients caps a MsgMultiMint at 1000 recipients.
+	DefaultMaxMultiMintRecipients = 1000
+	// DefaultSupplyCheckpointEpochIdentifier records supply checkpoints daily.
+	DefaultSupplyCheckpointEpochIdentifier = "day"
+	// DefaultMaxTransferFeeBasisPoints caps transfer fees at 5%.
+	DefaultMaxTransferFeeBasisPoints = 500
+	// DefaultDistributionClaimPeriod gives holders 30 days to claim a
+	// distribution.
+	DefaultDistributionClaimPeriod = 30 * 24 * 60 * 60
+	// DefaultMaxActiveDistributions allows 10 claimable distributions per
+	// denom, each adding up to two store writes to every transfer of it.
+	DefaultMaxActiveDistributions = 10
+	// DefaultMaxTransferFeeExemptAddresses lets a transfer fee exempt 50
+	// addresses.
+	DefaultMaxTransferFeeExemptAddresses = 50
+	// MaxBasisPoints is 100% in basis points.
+	MaxBasisPoints = 10_000
+)
 
 // NewParams creates a new Params instance.
 func NewParams(
+	mintEpochIdentifier string,
+	denomCreationFee sdk.Coins,
+	burnDenomCreationFee bool,
+	denomCreationGasConsume uint64,
+	maxDescriptionLength uint64,
+	maxURLLength uint64,
+	maxPrecision uint32,
+	enableErc20Registration bool,
+	allowedHookCodeIDs []uint64,
+	maxMultiMintRecipients uint64,
+	supplyCheckpointEpochIdentifier string,
+	maxTransferFeeBasisPoints uint32,
+	distributionClaimPeriod uint64,
+	maxActiveDistributions uint64,
+	maxTransferFeeExemptAddresses uint64,
 ) Params {
 	return Params{
+		MintEpochIdentifier:     mintEpochIdentifier,
+		DenomCreationFee:        denomCreationFee,
+		BurnDenomCreationFee:    burnDenomCreationFee,
+		DenomCreationGasConsume: denomCreationGasConsume,
+		MaxDescriptionLength:    maxDescriptionLength,
+		MaxUrlLength:            maxURLLength,
+		MaxPrecision:            maxPrecision,
+		EnableErc20Registration: enableErc20Registration,
+		AllowedHookCodeIds:      allowedHookCodeIDs,
+		MaxMultiMintRecipients:  maxMultiMintRecipients,
+
+		SupplyCheckpointEpochIdentifier: supplyCheckpointEpochIdentifier,
+		MaxTransferFeeBasisPoints:       maxTransferFeeBasisPoints,
+		DistributionClaimPeriod:         distributionClaimPeriod,
+		MaxActiveDistributions:          maxActiveDistributions,
+		MaxTransferFeeExemptAddresses:   maxTransferFeeExemptAddresses,
 	}
 }
 
-// DefaultParams returns a default set of parameters.
+// DefaultParams returns a default set of parameters. Creating a denom is free
+// until governance sets a fee or extra gas, and new denoms get an ERC-20 token
+// pair unless they opt out. No code ID is allowed as a before-send hook until
+// governance lists one.
 func DefaultParams() Params {
 	return NewParams(
+		DefaultMintEpochIdentifier,
+		nil,
+		false,
+		0,
+		DefaultMaxDescriptionLength,
+		DefaultMaxURLLength,
+		DefaultMaxPrecision,
+		true,
+		nil,
+		DefaultMaxMultiMintRecipients,
+		DefaultSupplyCheckpointEpochIdentifier,
+		DefaultMaxTransferFeeBasisPoints,
+		DefaultDistributionClaimPeriod,
+		DefaultMaxActiveDistributions,
+		DefaultMaxTransferFeeExemptAddresses,
 	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if strings.TrimSpace(p.MintEpochIdentifier) == "" {
+		return fmt.Errorf("mint epoch identifier cannot be blank")
+	}
+
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	if p.MaxDescriptionLength == 0 {
+		return fmt.Errorf("max description length must be positive")
+	}
+
+	if p.MaxUrlLength == 0 {
+		return fmt.Errorf("max url length must be positive")
+	}
+
+	if p.MaxMultiMintRecipients == 0 {
+		return fmt.Errorf("max multi mint recipients must be positive")
+	}
+
+	if strings.TrimSpace(p.SupplyCheckpointEpochIdentifier) == "" {
+		return fmt.Errorf("supply checkpoint epoch identifier cannot be blank")
+	}
+
+	if p.MaxTransferFeeBasisPoints > MaxBasisPoints {
+		return fmt.Errorf("max transfer fee cannot exceed %d basis points", MaxBasisPoints)
+	}
+
+	if p.DistributionClaimPeriod == 0 {
+		return fmt.Errorf("distribution claim period must be positive")
+	}
+
+	if p.MaxActiveDistributions == 0 {
+		return fmt.Errorf("max active distributions must be positive")
+	}
+
+	if p.MaxTransferFeeExemptAddresses == 0 {
+		return fmt.Errorf("max transfer fee exempt addresses must be positive")
+	}
+
+	seenCodeIDs := make(map[uint64]struct{}, len(p.AllowedHookCodeIds))
+	for _, codeID := range p.AllowedHookCodeIds {
+		if codeID == 0 {
+			return fmt.Errorf("allowed hook code id cannot be zero")
+		}
+		if _, ok := seenCodeIDs[codeID]; ok {
+			return fmt.Errorf("duplicated allowed hook code id %d", codeID)
+		}
+		seenCodeIDs[codeID] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsHookCodeIDAllowed reports whether contracts of codeID may be set as a
+// before-send hook.
+func (p Params) IsHookCodeIDAllowed(codeID uint64) bool {
+	return slices.Contains(p.AllowedHookCodeIds, codeID)
+}
+
+// ValidateDenomFields checks the description, url and precision of a denom
+// against the limits set by governance.
+func (p Params) ValidateDenomFields(description, url string, precision int64) error {
+	if uint64(len(description)) > p.MaxDescriptionLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "description exceeds %d bytes", p.MaxDescriptionLength)
+	}
+
+	if uint64(len(url)) > p.MaxUrlLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "url exceeds %d bytes", p.MaxUrlLength)
+	}
+
+	if precision > int64(p.MaxPrecision) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "precision exceeds %d", p.MaxPrecision)
+	}
 
 	return nil
 }
 
+// ValidateTransferFee checks the exempt addresses of fee against the limit set
+// by governance.
+func (p Params) ValidateTransferFee(fee TransferFee) error {
+	if uint64(len(fee.ExemptAddresses)) > p.MaxTransferFeeExemptAddresses {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "transfer fee exempts more than %d addresses", p.MaxTransferFeeExemptAddresses)
+	}
 
+	return nil
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,50 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// mint_epoch_identifier is the x/epochs identifier whose end resets the
+	// per-epoch mint counters of minters.
+	MintEpochIdentifier string `protobuf:"bytes,1,opt,name=mint_epoch_identifier,json=mintEpochIdentifier,proto3" json:"mint_epoch_identifier,omitempty"`
+	// denom_creation_fee is charged to the owner of every new denom.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
+	// burn_denom_creation_fee burns the creation fee instead of sending it to
+	// the community pool.
+	BurnDenomCreationFee bool `protobuf:"varint,3,opt,name=burn_denom_creation_fee,json=burnDenomCreationFee,proto3" json:"burn_denom_creation_fee,omitempty"`
+	// denom_creation_gas_consume is extra gas charged for creating a denom.
+	DenomCreationGasConsume uint64 `protobuf:"varint,4,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty"`
+	// max_description_length caps the length of a denom description.
+	MaxDescriptionLength uint64 `protobuf:"varint,5,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
+	// max_url_length caps the length of a denom url.
+	MaxUrlLength uint64 `protobuf:"varint,6,opt,name=max_url_length,json=maxUrlLength,proto3" json:"max_url_length,omitempty"`
+	// max_precision caps the number of decimals of a denom.
+	MaxPrecision uint32 `protobuf:"varint,7,opt,name=max_precision,json=maxPrecision,proto3" json:"max_precision,omitempty"`
+	// enable_erc20_registration registers an ERC-20 token pair for every new
+	// denom that does not opt out.
+	EnableErc20Registration bool `protobuf:"varint,8,opt,name=enable_erc20_registration,json=enableErc20Registration,proto3" json:"enable_erc20_registration,omitempty"`
+	// allowed_hook_code_ids lists the wasm code IDs whose contracts an owner may
+	// set as the before-send hook of a denom.
+	AllowedHookCodeIds []uint64 `protobuf:"varint,9,rep,packed,name=allowed_hook_code_ids,json=allowedHookCodeIds,proto3" json:"allowed_hook_code_ids,omitempty"`
+	// max_multi_mint_recipients caps the number of recipients of a single
+	// MsgMultiMint.
+	MaxMultiMintRecipients uint64 `protobuf:"varint,10,opt,name=max_multi_mint_recipients,json=maxMultiMintRecipients,proto3" json:"max_multi_mint_recipients,omitempty"`
+	// supply_checkpoint_epoch_identifier is the x/epochs identifier whose end
+	// records a supply checkpoint for every denom.
+	SupplyCheckpointEpochIdentifier string `protobuf:"bytes,11,opt,name=supply_checkpoint_epoch_identifier,json=supplyCheckpointEpochIdentifier,proto3" json:"supply_checkpoint_epoch_identifier,omitempty"`
+	// max_transfer_fee_basis_points caps the transfer fee of every denom. Fees
+	// configured above a lowered cap are charged at the cap.
+	MaxTransferFeeBasisPoints uint32 `protobuf:"varint,12,opt,name=max_transfer_fee_basis_points,json=maxTransferFeeBasisPoints,proto3" json:"max_transfer_fee_basis_points,omitempty"`
+	// distribution_claim_period is the number of seconds holders have to claim
+	// a distribution before the rest returns to its creator.
+	DistributionClaimPeriod uint64 `protobuf:"varint,13,opt,name=distribution_claim_period,json=distributionClaimPeriod,proto3" json:"distribution_claim_period,omitempty"`
+	// max_active_distributions caps the distributions of a denom that can be
+	// claimed at the same time. Every transfer of the denom records the
+	// balances of its parties for each of them, so each active distribution
+	// adds up to two store writes to every transfer until it is fully claimed
+	// or expires.
+	MaxActiveDistributions uint64 `protobuf:"varint,14,opt,name=max_active_distributions,json=maxActiveDistributions,proto3" json:"max_active_distributions,omitempty"`
+	// max_transfer_fee_exempt_addresses caps the addresses a transfer fee
+	// exempts, since they are stored in the denom and read on every transfer of
+	// it.
+	MaxTransferFeeExemptAddresses uint64 `protobuf:"varint,15,opt,name=max_transfer_fee_exempt_addresses,json=maxTransferFeeExemptAddresses,proto3" json:"max_transfer_fee_exempt_addresses,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +107,111 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMintEpochIdentifier() string {
+	if m != nil {
+		return m.MintEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetBurnDenomCreationFee() bool {
+	if m != nil {
+		return m.BurnDenomCreationFee
+	}
+	return false
+}
+
+func (m *Params) GetDenomCreationGasConsume() uint64 {
+	if m != nil {
+		return m.DenomCreationGasConsume
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDescriptionLength() uint64 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUrlLength() uint64 {
+	if m != nil {
+		return m.MaxUrlLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrecision() uint32 {
+	if m != nil {
+		return m.MaxPrecision
+	}
+	return 0
+}
+
+func (m *Params) GetEnableErc20Registration() bool {
+	if m != nil {
+		return m.EnableErc20Registration
+	}
+	return false
+}
+
+func (m *Params) GetAllowedHookCodeIds() []uint64 {
+	if m != nil {
+		return m.AllowedHookCodeIds
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMultiMintRecipients() uint64 {
+	if m != nil {
+		return m.MaxMultiMintRecipients
+	}
+	return 0
+}
+
+func (m *Params) GetSupplyCheckpointEpochIdentifier() string {
+	if m != nil {
+		return m.SupplyCheckpointEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxTransferFeeBasisPoints() uint32 {
+	if m != nil {
+		return m.MaxTransferFeeBasisPoints
+	}
+	return 0
+}
+
+func (m *Params) GetDistributionClaimPeriod() uint64 {
+	if m != nil {
+		return m.DistributionClaimPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxActiveDistributions() uint64 {
+	if m != nil {
+		return m.MaxActiveDistributions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTransferFeeExemptAddresses() uint64 {
+	if m != nil {
+		return m.MaxTransferFeeExemptAddresses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "nimochain.tokenfactory.v1.Params")
 }
@@ -70,18 +221,52 @@ func init() {
 }
 
 var fileDescriptor_b7f7705b3bf2693d = []byte{
-	// 168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0xcb, 0xcc, 0xcd,
-	0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9,
-	0x2f, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x84, 0xab, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0xaa, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c,
-	0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc4, 0xc5, 0x16, 0x00, 0x36, 0xd3, 0x4a, 0xe3, 0xc5, 0x02,
-	0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xe4, 0x11, 0xd6, 0x57, 0xa0, 0x3a, 0x00, 0xa2, 0xd2, 0xc9,
-	0xf2, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xc0, 0x5a, 0x75, 0xb1, 0xea,
-	0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xdb, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0xe6, 0x10, 0xd7, 0x88, 0xe3, 0x00, 0x00, 0x00,
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0xc7, 0xbd, 0x85, 0xf2, 0x32, 0xbc, 0xb4, 0xdd, 0x42, 0x59, 0x23, 0x61, 0xbb, 0xb4, 0xaa,
+	0x56, 0x48, 0xec, 0xd6, 0xb4, 0x95, 0x0a, 0xbd, 0x14, 0x0c, 0x14, 0xd4, 0x22, 0x59, 0x56, 0x7b,
+	0xe9, 0x65, 0x34, 0x3b, 0xfb, 0xd8, 0x1e, 0x79, 0x77, 0x66, 0x35, 0x33, 0x76, 0xec, 0xaf, 0x90,
+	0x4b, 0x72, 0xce, 0x29, 0xc7, 0x28, 0x27, 0x3e, 0x06, 0x47, 0x8e, 0x39, 0x25, 0x11, 0x48, 0x21,
+	0x1f, 0x23, 0x9a, 0xd9, 0xc5, 0x18, 0xc2, 0x05, 0x56, 0xfb, 0xfb, 0x3d, 0x7e, 0xfe, 0xf3, 0xcc,
+	0xec, 0xa0, 0x9f, 0x38, 0x4b, 0x05, 0xed, 0x12, 0xc6, 0x43, 0x2d, 0x7a, 0xc0, 0xdb, 0x84, 0x6a,
+	0x21, 0x47, 0xe1, 0xa0, 0x1e, 0x66, 0x44, 0x92, 0x54, 0x05, 0x99, 0x14, 0x5a, 0xb8, 0xe5, 0xb1,
+	0x17, 0x4c, 0x7a, 0xc1, 0xa0, 0xbe, 0xfe, 0x0d, 0x49, 0x19, 0x17, 0xa1, 0xfd, 0x9b, 0xdb, 0xeb,
+	0x15, 0x2a, 0x54, 0x2a, 0x54, 0x18, 0x11, 0x05, 0xe1, 0xa0, 0x1e, 0x81, 0x26, 0xf5, 0x90, 0x0a,
+	0xc6, 0x0b, 0xbe, 0xd2, 0x11, 0x1d, 0x61, 0x1f, 0x43, 0xf3, 0x94, 0xbf, 0xdd, 0xfc, 0x30, 0x8b,
+	0x66, 0x9a, 0xb6, 0xa9, 0xbb, 0x83, 0x56, 0x53, 0xc6, 0x35, 0x86, 0x4c, 0xd0, 0x2e, 0x66, 0x31,
+	0x70, 0xcd, 0xda, 0x0c, 0xa4, 0xe7, 0xd4, 0x1c, 0x7f, 0xbe, 0xf5, 0xad, 0x81, 0x47, 0x86, 0x9d,
+	0x8e, 0x91, 0xfb, 0xcc, 0x41, 0x6e, 0x0c, 0x5c, 0xa4, 0x98, 0x4a, 0x20, 0x9a, 0x09, 0x8e, 0xdb,
+	0x00, 0xde, 0x17, 0xb5, 0x29, 0x7f, 0x61, 0xa7, 0x1c, 0xe4, 0x91, 0x02, 0x13, 0x29, 0x28, 0x22,
+	0x05, 0x0d, 0xc1, 0xf8, 0xc1, 0xf1, 0xc5, 0xdb, 0x6a, 0xe9, 0xf5, 0xbb, 0xaa, 0xdf, 0x61, 0xba,
+	0xdb, 0x8f, 0x02, 0x2a, 0xd2, 0xb0, 0xc8, 0x9f, 0xff, 0xdb, 0x56, 0x71, 0x2f, 0xd4, 0xa3, 0x0c,
+	0x94, 0x2d, 0x50, 0x2f, 0x6e, 0xce, 0xb7, 0x16, 0x13, 0xe8, 0x10, 0x3a, 0xc2, 0x66, 0x51, 0xea,
+	0xd5, 0xcd, 0xf9, 0x96, 0xd3, 0xfa, 0xda, 0x36, 0x6f, 0x14, 0xbd, 0x8f, 0x01, 0xdc, 0xdf, 0xd0,
+	0x5a, 0xd4, 0x97, 0x1c, 0x3f, 0x92, 0x6a, 0xaa, 0xe6, 0xf8, 0x73, 0xad, 0x15, 0x83, 0x0f, 0x1f,
+	0x96, 0xfd, 0x81, 0xd6, 0x1f, 0x54, 0x74, 0x88, 0xc2, 0x54, 0x70, 0xd5, 0x4f, 0xc1, 0x9b, 0xae,
+	0x39, 0xfe, 0x74, 0x6b, 0xed, 0x5e, 0xb3, 0xbf, 0x88, 0x6a, 0xe4, 0xd8, 0xfd, 0x15, 0x7d, 0x97,
+	0x92, 0x21, 0x8e, 0x41, 0x51, 0xc9, 0x32, 0x5b, 0x9d, 0x00, 0xef, 0xe8, 0xae, 0xf7, 0xa5, 0x2d,
+	0x5c, 0x49, 0xc9, 0xf0, 0xf0, 0x0e, 0xfe, 0x63, 0x99, 0xfb, 0x23, 0x5a, 0x36, 0x55, 0x7d, 0x99,
+	0xdc, 0xda, 0x33, 0xd6, 0x5e, 0x4c, 0xc9, 0xf0, 0x3f, 0x99, 0x14, 0xd6, 0x0f, 0x68, 0xc9, 0x58,
+	0x99, 0x04, 0xca, 0x14, 0x13, 0xdc, 0x9b, 0xad, 0x39, 0xfe, 0x92, 0x95, 0x9a, 0xb7, 0xef, 0xdc,
+	0x3d, 0x54, 0x06, 0x4e, 0xa2, 0x04, 0x30, 0x48, 0xba, 0xf3, 0x33, 0x96, 0xd0, 0x61, 0x4a, 0x4b,
+	0x9b, 0xd3, 0x9b, 0xb3, 0xcb, 0x5e, 0xcb, 0x85, 0x23, 0xc3, 0x5b, 0x13, 0xd8, 0xad, 0xa3, 0x55,
+	0x92, 0x24, 0xe2, 0x09, 0xc4, 0xb8, 0x2b, 0x44, 0x0f, 0x53, 0x11, 0x03, 0x66, 0xb1, 0xf2, 0xe6,
+	0x6b, 0x53, 0xfe, 0x74, 0xcb, 0x2d, 0xe0, 0x89, 0x10, 0xbd, 0x86, 0x88, 0xe1, 0x34, 0x56, 0xee,
+	0x2e, 0x2a, 0x9b, 0x4c, 0x69, 0x3f, 0xd1, 0x0c, 0xdb, 0x33, 0x63, 0x92, 0x64, 0x0c, 0xb8, 0x56,
+	0x1e, 0xb2, 0x8b, 0x30, 0x03, 0x39, 0x33, 0xfc, 0x8c, 0x71, 0xdd, 0x1a, 0x53, 0xf7, 0x6f, 0xb4,
+	0xa9, 0xfa, 0x59, 0x96, 0x8c, 0x30, 0xed, 0x02, 0xed, 0x65, 0xe2, 0xd1, 0x13, 0xb7, 0x60, 0x4f,
+	0x5c, 0x35, 0x37, 0x1b, 0x63, 0xf1, 0xe1, 0xe9, 0xfb, 0x13, 0x6d, 0x98, 0x1c, 0x5a, 0x12, 0xae,
+	0xda, 0x20, 0xcd, 0x26, 0xe3, 0x88, 0x28, 0xa6, 0xb0, 0xd5, 0x95, 0xb7, 0x68, 0x67, 0x65, 0xc2,
+	0xfe, 0x5b, 0x38, 0xc7, 0x00, 0x07, 0xc6, 0x68, 0x5a, 0xc1, 0x0c, 0x2e, 0x36, 0xa3, 0x60, 0x51,
+	0xdf, 0x6e, 0x1b, 0x4d, 0x08, 0x4b, 0x71, 0x06, 0x92, 0x89, 0xd8, 0x5b, 0x2a, 0x76, 0x7d, 0x42,
+	0x68, 0x18, 0xde, 0xb4, 0xd8, 0xfd, 0x1d, 0x79, 0xa6, 0x3b, 0xa1, 0x9a, 0x0d, 0x00, 0x4f, 0x5a,
+	0xca, 0x5b, 0x1e, 0x0f, 0x61, 0xdf, 0xe2, 0xc3, 0x49, 0xea, 0x9e, 0xa0, 0xef, 0x3f, 0xcb, 0x0d,
+	0x43, 0x48, 0x33, 0x8d, 0x49, 0x1c, 0x4b, 0x50, 0x0a, 0x94, 0xf7, 0x95, 0xfd, 0x89, 0x8d, 0xfb,
+	0xd9, 0x8f, 0xac, 0xb5, 0x7f, 0x2b, 0xed, 0xf9, 0x1f, 0x5f, 0x56, 0x9d, 0xa7, 0x37, 0xe7, 0x5b,
+	0xd5, 0xbb, 0x3b, 0x65, 0x78, 0xff, 0x56, 0xc9, 0xbf, 0xee, 0x83, 0xdd, 0x8b, 0xab, 0x8a, 0x73,
+	0x79, 0x55, 0x71, 0xde, 0x5f, 0x55, 0x9c, 0xe7, 0xd7, 0x95, 0xd2, 0xe5, 0x75, 0xa5, 0xf4, 0xe6,
+	0xba, 0x52, 0xfa, 0xdf, 0x96, 0x6e, 0x3f, 0x5a, 0x6b, 0x3f, 0xc0, 0x68, 0xc6, 0x5e, 0x15, 0xbf,
+	0x7c, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x68, 0xee, 0xb0, 0x07, 0xb8, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -103,6 +288,61 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MintEpochIdentifier != that1.MintEpochIdentifier {
+		return false
+	}
+	if len(this.DenomCreationFee) != len(that1.DenomCreationFee) {
+		return false
+	}
+	for i := range this.DenomCreationFee {
+		if !this.DenomCreationFee[i].Equal(&that1.DenomCreationFee[i]) {
+			return false
+		}
+	}
+	if this.BurnDenomCreationFee != that1.BurnDenomCreationFee {
+		return false
+	}
+	if this.DenomCreationGasConsume != that1.DenomCreationGasConsume {
+		return false
+	}
+	if this.MaxDescriptionLength != that1.MaxDescriptionLength {
+		return false
+	}
+	if this.MaxUrlLength != that1.MaxUrlLength {
+		return false
+	}
+	if this.MaxPrecision != that1.MaxPrecision {
+		return false
+	}
+	if this.EnableErc20Registration != that1.EnableErc20Registration {
+		return false
+	}
+	if len(this.AllowedHookCodeIds) != len(that1.AllowedHookCodeIds) {
+		return false
+	}
+	for i := range this.AllowedHookCodeIds {
+		if this.AllowedHookCodeIds[i] != that1.AllowedHookCodeIds[i] {
+			return false
+		}
+	}
+	if this.MaxMultiMintRecipients != that1.MaxMultiMintRecipients {
+		return false
+	}
+	if this.SupplyCheckpointEpochIdentifier != that1.SupplyCheckpointEpochIdentifier {
+		return false
+	}
+	if this.MaxTransferFeeBasisPoints != that1.MaxTransferFeeBasisPoints {
+		return false
+	}
+	if this.DistributionClaimPeriod != that1.DistributionClaimPeriod {
+		return false
+	}
+	if this.MaxActiveDistributions != that1.MaxActiveDistributions {
+		return false
+	}
+	if this.MaxTransferFeeExemptAddresses != that1.MaxTransferFeeExemptAddresses {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +365,117 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTransferFeeExemptAddresses != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransferFeeExemptAddresses))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxActiveDistributions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveDistributions))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DistributionClaimPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DistributionClaimPeriod))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MaxTransferFeeBasisPoints != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTransferFeeBasisPoints))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.SupplyCheckpointEpochIdentifier) > 0 {
+		i -= len(m.SupplyCheckpointEpochIdentifier)
+		copy(dAtA[i:], m.SupplyCheckpointEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SupplyCheckpointEpochIdentifier)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxMultiMintRecipients != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMultiMintRecipients))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AllowedHookCodeIds) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedHookCodeIds)*10)
+		var j1 int
+		for _, num := range m.AllowedHookCodeIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EnableErc20Registration {
+		i--
+		if m.EnableErc20Registration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxPrecision != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrecision))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxUrlLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUrlLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxDescriptionLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDescriptionLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DenomCreationGasConsume != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BurnDenomCreationFee {
+		i--
+		if m.BurnDenomCreationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MintEpochIdentifier) > 0 {
+		i -= len(m.MintEpochIdentifier)
+		copy(dAtA[i:], m.MintEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MintEpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -145,6 +496,60 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.MintEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.BurnDenomCreationFee {
+		n += 2
+	}
+	if m.DenomCreationGasConsume != 0 {
+		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxDescriptionLength))
+	}
+	if m.MaxUrlLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxUrlLength))
+	}
+	if m.MaxPrecision != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrecision))
+	}
+	if m.EnableErc20Registration {
+		n += 2
+	}
+	if len(m.AllowedHookCodeIds) > 0 {
+		l = 0
+		for _, e := range m.AllowedHookCodeIds {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.MaxMultiMintRecipients != 0 {
+		n += 1 + sovParams(uint64(m.MaxMultiMintRecipients))
+	}
+	l = len(m.SupplyCheckpointEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxTransferFeeBasisPoints != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransferFeeBasisPoints))
+	}
+	if m.DistributionClaimPeriod != 0 {
+		n += 1 + sovParams(uint64(m.DistributionClaimPeriod))
+	}
+	if m.MaxActiveDistributions != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveDistributions))
+	}
+	if m.MaxTransferFeeExemptAddresses != 0 {
+		n += 1 + sovParams(uint64(m.MaxTransferFeeExemptAddresses))
+	}
 	return n
 }
 
@@ -183,6 +588,391 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDenomCreationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnDenomCreationFee = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationGasConsume", wireType)
+			}
+			m.DenomCreationGasConsume = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomCreationGasConsume |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
+			}
+			m.MaxDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDescriptionLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUrlLength", wireType)
+			}
+			m.MaxUrlLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUrlLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrecision", wireType)
+			}
+			m.MaxPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrecision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20Registration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableErc20Registration = bool(v != 0)
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedHookCodeIds = append(m.AllowedHookCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AllowedHookCodeIds) == 0 {
+					m.AllowedHookCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedHookCodeIds = append(m.AllowedHookCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedHookCodeIds", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiMintRecipients", wireType)
+			}
+			m.MaxMultiMintRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMultiMintRecipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCheckpointEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyCheckpointEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferFeeBasisPoints", wireType)
+			}
+			m.MaxTransferFeeBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransferFeeBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaimPeriod", wireType)
+			}
+			m.DistributionClaimPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionClaimPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveDistributions", wireType)
+			}
+			m.MaxActiveDistributions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveDistributions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTransferFeeExemptAddresses", wireType)
+			}
+			m.MaxTransferFeeExemptAddresses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTransferFeeExemptAddresses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nimochain/tokenfactory/v1/role.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role defines a permission the denom owner can grant to an address.
+type Role int32
+
+const (
+	// ROLE_UNSPECIFIED is not a valid role.
+	ROLE_UNSPECIFIED Role = 0
+	// ROLE_MINTER allows minting the denom.
+	ROLE_MINTER Role = 1
+	// ROLE_BURNER allows burning approved balances of other holders.
+	ROLE_BURNER Role = 2
+	// ROLE_METADATA_ADMIN allows updating the denom description, url and max supply.
+	ROLE_METADATA_ADMIN Role = 3
+	// ROLE_PAUSER allows pausing the denom. Only the owner can unpause it.
+	ROLE_PAUSER Role = 4
+	// ROLE_CLAWBACK allows force-transferring the denom out of any account. It
+	// can only be granted on denoms created with clawback enabled.
+	ROLE_CLAWBACK Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_MINTER",
+	2: "ROLE_BURNER",
+	3: "ROLE_METADATA_ADMIN",
+	4: "ROLE_PAUSER",
+	5: "ROLE_CLAWBACK",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":    0,
+	"ROLE_MINTER":         1,
+	"ROLE_BURNER":         2,
+	"ROLE_METADATA_ADMIN": 3,
+	"ROLE_PAUSER":         4,
+	"ROLE_CLAWBACK":       5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_869cb45de4d0d868, []int{0}
+}
+
+// RoleGrant defines the roles an address holds for a denom.
+type RoleGrant struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Roles   []Role `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=nimochain.tokenfactory.v1.Role" json:"roles,omitempty"`
+	// mint_allowance caps how much a minter may still mint. Unset means unlimited.
+	MintAllowance *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mint_allowance,json=mintAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"mint_allowance,omitempty"`
+	// per_epoch_limit caps how much a minter may mint within one mint epoch.
+	// Unset means unlimited.
+	PerEpochLimit *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=per_epoch_limit,json=perEpochLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_epoch_limit,omitempty"`
+}
+
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869cb45de4d0d868, []int{0}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// EpochMint defines how much a minter has minted in the current mint epoch.
+type EpochMint struct {
+	Denom  string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Minter string                `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *EpochMint) Reset()         { *m = EpochMint{} }
+func (m *EpochMint) String() string { return proto.CompactTextString(m) }
+func (*EpochMint) ProtoMessage()    {}
+func (*EpochMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869cb45de4d0d868, []int{1}
+}
+func (m *EpochMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochMint.Merge(m, src)
+}
+func (m *EpochMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochMint proto.InternalMessageInfo
+
+func (m *EpochMint) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EpochMint) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("nimochain.tokenfactory.v1.Role", Role_name, Role_value)
+	proto.RegisterType((*RoleGrant)(nil), "nimochain.tokenfactory.v1.RoleGrant")
+	proto.RegisterType((*EpochMint)(nil), "nimochain.tokenfactory.v1.EpochMint")
+}
+
+func init() {
+	proto.RegisterFile("nimochain/tokenfactory/v1/role.proto", fileDescriptor_869cb45de4d0d868)
+}
+
+var fileDescriptor_869cb45de4d0d868 = []byte{
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4f, 0x6b, 0xd4, 0x40,
+	0x1c, 0x4d, 0xf6, 0x9f, 0xec, 0x48, 0xdb, 0x38, 0xae, 0x9a, 0xee, 0x21, 0x5b, 0x8a, 0x60, 0x51,
+	0x9a, 0x50, 0xc5, 0x83, 0xc7, 0xec, 0x6e, 0x94, 0xe0, 0x6e, 0x5a, 0xa6, 0xbb, 0x08, 0x5e, 0x42,
+	0xcc, 0x8e, 0xdd, 0xd0, 0x64, 0x7e, 0x61, 0x32, 0x56, 0x7b, 0x11, 0xbc, 0x79, 0x14, 0xfc, 0x08,
+	0x7e, 0x05, 0x3f, 0x44, 0x8f, 0xc5, 0x93, 0x78, 0x28, 0xb2, 0xfb, 0x45, 0x64, 0x32, 0xe9, 0xa2,
+	0x87, 0x82, 0xbd, 0xcd, 0x7b, 0xbc, 0xf7, 0x9b, 0x37, 0xbf, 0x79, 0xe8, 0x3e, 0x4b, 0x32, 0x88,
+	0xe7, 0x51, 0xc2, 0x1c, 0x01, 0xc7, 0x94, 0xbd, 0x8d, 0x62, 0x01, 0xfc, 0xd4, 0x39, 0xd9, 0x73,
+	0x38, 0xa4, 0xd4, 0xce, 0x39, 0x08, 0xc0, 0x9b, 0x2b, 0x95, 0xfd, 0xb7, 0xca, 0x3e, 0xd9, 0xeb,
+	0x6e, 0xc6, 0x50, 0x64, 0x50, 0x84, 0xa5, 0xd0, 0x51, 0x40, 0xb9, 0xba, 0x9d, 0x23, 0x38, 0x02,
+	0xc5, 0xcb, 0x93, 0x62, 0xb7, 0xbf, 0xd6, 0x50, 0x9b, 0x40, 0x4a, 0x5f, 0xf0, 0x88, 0x09, 0xdc,
+	0x41, 0xcd, 0x19, 0x65, 0x90, 0x99, 0xfa, 0x96, 0xbe, 0xd3, 0x26, 0x0a, 0x60, 0x13, 0xdd, 0x88,
+	0x66, 0x33, 0x4e, 0x8b, 0xc2, 0xac, 0x95, 0xfc, 0x25, 0xc4, 0x4f, 0x51, 0x53, 0xe6, 0x2a, 0xcc,
+	0xfa, 0x56, 0x7d, 0x67, 0xfd, 0x71, 0xcf, 0xbe, 0x32, 0x99, 0x2d, 0x2f, 0x21, 0x4a, 0x8d, 0x03,
+	0xb4, 0x9e, 0x25, 0x4c, 0x84, 0x51, 0x9a, 0xc2, 0xfb, 0x88, 0xc5, 0xd4, 0x6c, 0xc8, 0xb9, 0xfd,
+	0x07, 0xbf, 0x2e, 0x7a, 0x77, 0x54, 0xe8, 0x62, 0x76, 0x6c, 0x27, 0xe0, 0x64, 0x91, 0x98, 0xdb,
+	0x3e, 0x13, 0x3f, 0xbe, 0xef, 0xa2, 0xea, 0x35, 0x3e, 0x13, 0x64, 0x4d, 0xda, 0xdd, 0x4b, 0x37,
+	0xde, 0x47, 0x1b, 0x39, 0xe5, 0x21, 0xcd, 0x21, 0x9e, 0x87, 0x69, 0x92, 0x25, 0xc2, 0x6c, 0x5e,
+	0x73, 0x60, 0x4e, 0xb9, 0x27, 0xed, 0x23, 0xe9, 0xde, 0xfe, 0x88, 0xda, 0x25, 0x1a, 0x27, 0x57,
+	0x2e, 0xe5, 0x2e, 0x6a, 0xc9, 0x10, 0x94, 0x57, 0x3b, 0xa9, 0x10, 0x1e, 0xa0, 0x56, 0x94, 0xc1,
+	0x3b, 0x26, 0xcc, 0x7a, 0x19, 0xe1, 0xd1, 0xd9, 0x45, 0x4f, 0xfb, 0xdf, 0x18, 0x95, 0xf5, 0xe1,
+	0x27, 0x1d, 0x35, 0xe4, 0xc2, 0x70, 0x07, 0x19, 0x64, 0x7f, 0xe4, 0x85, 0xd3, 0xe0, 0xf0, 0xc0,
+	0x1b, 0xf8, 0xcf, 0x7d, 0x6f, 0x68, 0x68, 0x78, 0x03, 0xdd, 0x2c, 0xd9, 0xb1, 0x1f, 0x4c, 0x3c,
+	0x62, 0xe8, 0x2b, 0xa2, 0x3f, 0x25, 0x81, 0x47, 0x8c, 0x1a, 0xbe, 0x87, 0x6e, 0x2b, 0x85, 0x37,
+	0x71, 0x87, 0xee, 0xc4, 0x0d, 0xdd, 0xe1, 0xd8, 0x0f, 0x8c, 0xfa, 0x4a, 0x79, 0xe0, 0x4e, 0x0f,
+	0x3d, 0x62, 0x34, 0xf0, 0x2d, 0xb4, 0x56, 0x12, 0x83, 0x91, 0xfb, 0xaa, 0xef, 0x0e, 0x5e, 0x1a,
+	0xcd, 0x6e, 0xe3, 0xf3, 0x37, 0x4b, 0xeb, 0x3f, 0x3b, 0x5b, 0x58, 0xfa, 0xf9, 0xc2, 0xd2, 0x7f,
+	0x2f, 0x2c, 0xfd, 0xcb, 0xd2, 0xd2, 0xce, 0x97, 0x96, 0xf6, 0x73, 0x69, 0x69, 0xaf, 0x7b, 0xf2,
+	0x97, 0x77, 0x55, 0x4d, 0x3f, 0xfc, 0x5b, 0x54, 0x71, 0x9a, 0xd3, 0xe2, 0x4d, 0xab, 0xec, 0xd6,
+	0x93, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x9b, 0xb7, 0xdd, 0xcf, 0x02, 0x00, 0x00,
+}
+
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerEpochLimit != nil {
+		{
+			size := m.PerEpochLimit.Size()
+			i -= size
+			if _, err := m.PerEpochLimit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRole(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MintAllowance != nil {
+		{
+			size := m.MintAllowance.Size()
+			i -= size
+			if _, err := m.MintAllowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRole(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Roles) > 0 {
+		dAtA2 := make([]byte, len(m.Roles)*10)
+		var j1 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintRole(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRole(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRole(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRole(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovRole(uint64(e))
+		}
+		n += 1 + sovRole(uint64(l)) + l
+	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.PerEpochLimit != nil {
+		l = m.PerEpochLimit.Size()
+		n += 1 + l + sovRole(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRole(uint64(l))
+	return n
+}
+
+func sovRole(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRole(x uint64) (n int) {
+	return sovRole(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Role
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRole
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Role(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRole
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRole
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRole
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]Role, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Role
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRole
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Role(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MintAllowance = &v
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.PerEpochLimit = &v
+			if err := m.PerEpochLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRole(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRole
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRole
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRole
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRole        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRole          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRole = fmt.Errorf("proto: unexpected end of group")
+)
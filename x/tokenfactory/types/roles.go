@@ -0,0 +1,59 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AllRoles lists the roles denom creators start out with. The owner does not
+// need ROLE_CLAWBACK, which is only granted to other operators of clawback
+// enabled denoms.
+var AllRoles = []Role{ROLE_MINTER, ROLE_BURNER, ROLE_METADATA_ADMIN, ROLE_PAUSER}
+
+// IsValid reports whether r is a grantable role.
+func (r Role) IsValid() bool {
+	return r == ROLE_CLAWBACK || slices.Contains(AllRoles, r)
+}
+
+// HasRole reports whether the grant includes role.
+func (g RoleGrant) HasRole(role Role) bool {
+	return slices.Contains(g.Roles, role)
+}
+
+// AddRole adds role to the grant unless it is already present.
+func (g *RoleGrant) AddRole(role Role) {
+	if !g.HasRole(role) {
+		g.Roles = append(g.Roles, role)
+	}
+}
+
+// RemoveRole drops role from the grant. Removing the minter role also drops
+// the mint limits.
+func (g *RoleGrant) RemoveRole(role Role) {
+	g.Roles = slices.DeleteFunc(g.Roles, func(r Role) bool { return r == role })
+	if role == ROLE_MINTER {
+		g.MintAllowance = nil
+		g.PerEpochLimit = nil
+	}
+}
+
+// validateMintLimit checks that a mint limit is only set for minters and is
+// not negative.
+func validateMintLimit(roles []Role, limit *math.Int) error {
+	if limit == nil {
+		return nil
+	}
+
+	if !slices.Contains(roles, ROLE_MINTER) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mint limits require the minter role")
+	}
+
+	if limit.IsNil() || limit.IsNegative() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mint limits cannot be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that the fee is positive and within 100%, and that the
+// treasury and exempt addresses are valid.
+func (f TransferFee) Validate() error {
+	if f.BasisPoints == 0 || f.BasisPoints > MaxBasisPoints {
+		return fmt.Errorf("transfer fee must be between 1 and %d basis points", MaxBasisPoints)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(f.Treasury); err != nil {
+		return fmt.Errorf("invalid treasury address: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(f.ExemptAddresses))
+	for _, address := range f.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid exempt address %s: %w", address, err)
+		}
+		if _, ok := seen[address]; ok {
+			return fmt.Errorf("duplicated exempt address %s", address)
+		}
+		seen[address] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsExempt reports whether transfers from or to address are not charged.
+func (f TransferFee) IsExempt(address string) bool {
+	return address == f.Treasury || slices.Contains(f.ExemptAddresses, address)
+}
+
+// Fee returns the fee charged on amount, rounded down. The basis points are
+// capped at maxBasisPoints.
+func (f TransferFee) Fee(amount math.Int, maxBasisPoints uint32) math.Int {
+	basisPoints := min(f.BasisPoints, maxBasisPoints)
+	return amount.MulRaw(int64(basisPoints)).QuoRaw(MaxBasisPoints)
+}